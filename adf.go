@@ -0,0 +1,102 @@
+package jira
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// ADFDocument represents a document in the Atlassian Document Format, used by the
+// version 3 of the Jira Cloud API for rich text fields like comments and descriptions.
+//
+// ADF docs: https://developer.atlassian.com/cloud/jira/platform/apis/document/structure/
+type ADFDocument struct {
+	Version int        `json:"version"`
+	Type    string     `json:"type"`
+	Content []*ADFNode `json:"content"`
+}
+
+// ADFNode represents a node of an ADF document, e.g. paragraph, text or hardBreak
+type ADFNode struct {
+	Type    string                 `json:"type"`
+	Text    string                 `json:"text,omitempty"`
+	Attrs   map[string]interface{} `json:"attrs,omitempty"`
+	Marks   []*ADFMark             `json:"marks,omitempty"`
+	Content []*ADFNode             `json:"content,omitempty"`
+}
+
+// ADFMark represents the formatting applied to a text node, e.g. strong or link
+type ADFMark struct {
+	Type  string                 `json:"type"`
+	Attrs map[string]interface{} `json:"attrs,omitempty"`
+}
+
+// ADFBuilder builds ADF documents, e.g. NewADF().Paragraph("text").Build()
+type ADFBuilder struct {
+	doc *ADFDocument
+}
+
+// NewADF returns a builder of an empty ADF document
+func NewADF() *ADFBuilder {
+	return &ADFBuilder{
+		doc: &ADFDocument{
+			Version: 1,
+			Type:    "doc",
+			Content: []*ADFNode{},
+		},
+	}
+}
+
+// Paragraph appends a paragraph with the given text, line breaks are kept as hard breaks
+func (b *ADFBuilder) Paragraph(text string) *ADFBuilder {
+	p := &ADFNode{Type: "paragraph"}
+	for i, line := range strings.Split(text, "\n") {
+		if i > 0 {
+			p.Content = append(p.Content, &ADFNode{Type: "hardBreak"})
+		}
+		if line != "" {
+			p.Content = append(p.Content, &ADFNode{Type: "text", Text: line})
+		}
+	}
+
+	b.doc.Content = append(b.doc.Content, p)
+	return b
+}
+
+// Build returns the document
+func (b *ADFBuilder) Build() *ADFDocument {
+	return b.doc
+}
+
+// TextToADF converts a plain text to an ADF document, each block of text separated
+// by a blank line becomes a paragraph.
+func TextToADF(text string) *ADFDocument {
+	b := NewADF()
+	for _, p := range strings.Split(strings.Replace(text, "\r\n", "\n", -1), "\n\n") {
+		if p = strings.Trim(p, "\n"); p != "" {
+			b.Paragraph(p)
+		}
+	}
+
+	return b.Build()
+}
+
+// decodeRichText decodes a rich text field, returned either as a plain string
+// by the version 2 of the API or as an ADF document by the version 3.
+func decodeRichText(raw json.RawMessage) (string, *ADFDocument, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return "", nil, nil
+	}
+
+	if raw[0] == '"' {
+		var s string
+		err := json.Unmarshal(raw, &s)
+		return s, nil, err
+	}
+
+	doc := &ADFDocument{}
+	if err := json.Unmarshal(raw, doc); err != nil {
+		return "", nil, err
+	}
+
+	return "", doc, nil
+}
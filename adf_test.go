@@ -0,0 +1,53 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestADFBuilder(t *testing.T) {
+	doc := NewADF().Paragraph("Hello").Paragraph("first\nsecond").Build()
+
+	b, err := json.Marshal(doc)
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"version":1,"type":"doc","content":[
+		{"type":"paragraph","content":[{"type":"text","text":"Hello"}]},
+		{"type":"paragraph","content":[{"type":"text","text":"first"},{"type":"hardBreak"},{"type":"text","text":"second"}]}
+	]}`, string(b))
+}
+
+func TestTextToADF(t *testing.T) {
+	doc := TextToADF("first paragraph\r\n\r\nsecond paragraph\n")
+
+	assert.Len(t, doc.Content, 2)
+	assert.Equal(t, "first paragraph", doc.Content[0].Content[0].Text)
+	assert.Equal(t, "second paragraph", doc.Content[1].Content[0].Text)
+
+	assert.Len(t, TextToADF("").Content, 0)
+}
+
+func TestIssueCommentUnmarshalADF(t *testing.T) {
+	comment := &IssueComment{}
+	err := json.Unmarshal([]byte(`{"id": "1","body": {"version": 1,"type": "doc","content": [{"type": "paragraph","content": [{"type": "text","text": "Hi"}]}]}}`), comment)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "1", comment.ID)
+	assert.Equal(t, "", comment.Body)
+	assert.Equal(t, "Hi", comment.Document.Content[0].Content[0].Text)
+
+	err = json.Unmarshal([]byte(`{"id": "2","body": "Hi"}`), comment)
+	assert.Nil(t, err)
+	assert.Equal(t, "Hi", comment.Body)
+	assert.Nil(t, comment.Document)
+}
+
+func TestIssueFieldUnmarshalADF(t *testing.T) {
+	fields := &IssueField{}
+	err := json.Unmarshal([]byte(`{"summary": "S","description": {"version": 1,"type": "doc","content": []}}`), fields)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "S", fields.Summary)
+	assert.NotNil(t, fields.DescriptionDocument)
+}
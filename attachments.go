@@ -0,0 +1,55 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// AttachmentsService handles communication with the attachment related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/issue/{issueIdOrKey}/attachments
+type AttachmentsService service
+
+// Upload attaches a file to an issue, for a given issue Id or issue key. The content
+// of the file is read from r and streamed as a multipart/form-data request. It returns
+// the attachments created.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/attachments
+func (a *AttachmentsService) Upload(ctx context.Context, issueIDOrKey string, filename string, r io.Reader) ([]*IssueAttachment, *Response, error) {
+
+	req, err := a.client.newMultipartRequest("POST", a.client.apiPath(fmt.Sprintf("issue/%s/attachments", issueIDOrKey)), "file", filename, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer req.Body.Close()
+
+	var attachments []*IssueAttachment
+	resp, err := a.client.Do(ctx, req, &attachments)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return attachments, resp, nil
+}
+
+// Download returns the content of an attachment, for a given attachment Id. The
+// content is streamed from the server rather than read into memory, and the caller
+// must close the returned reader.
+//
+// GET /rest/api/2/attachment/content/{id}
+func (a *AttachmentsService) Download(ctx context.Context, attachmentID string) (io.ReadCloser, *Response, error) {
+
+	req, err := a.client.NewRequest("GET", a.client.apiPath(fmt.Sprintf("attachment/content/%s", attachmentID)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := a.client.stream(ctx, req)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resp.Body, resp, nil
+}
@@ -0,0 +1,140 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachmentsServiceUpload(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/attachments", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "no-check", r.Header.Get("X-Atlassian-Token"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data"))
+
+		f, h, err := r.FormFile("file")
+		assert.Nil(t, err)
+		defer f.Close()
+		content, _ := ioutil.ReadAll(f)
+		assert.Equal(t, "report.txt", h.Filename)
+		assert.Equal(t, "all good", string(content))
+
+		fmt.Fprint(w, `[{"id": "10000","filename": "report.txt","size": 8,"mimeType": "text/plain","created": "2020-03-10T09:30:00.000+0000","content": "https://jira.mycompany.com/secure/attachment/10000/report.txt"}]`)
+	})
+
+	attachments, _, err := client.Attachments.Upload(context.Background(), "MCP-1", "report.txt", strings.NewReader("all good"))
+	assert.Nil(t, err)
+	assert.Len(t, attachments, 1)
+	assert.Equal(t, "10000", attachments[0].ID)
+	assert.Equal(t, "report.txt", attachments[0].Filename)
+	assert.Equal(t, 8, attachments[0].Size)
+	assert.Equal(t, "text/plain", attachments[0].MimeType)
+	assert.Equal(t, "https://jira.mycompany.com/secure/attachment/10000/report.txt", attachments[0].Content)
+}
+
+func TestAttachmentsServiceUploadRetried(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRetry(2, time.Millisecond)(client)
+	WithRetryMethods(http.MethodPost)(client)
+
+	calls := 0
+	mux.HandleFunc("/api/2/issue/MCP-1/attachments", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		f, _, err := r.FormFile("file")
+		assert.Nil(t, err)
+		defer f.Close()
+		content, _ := ioutil.ReadAll(f)
+		assert.Equal(t, "all good", string(content))
+
+		if calls == 1 {
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `[{"id": "10000","filename": "report.txt"}]`)
+	})
+
+	attachments, resp, err := client.Attachments.Upload(context.Background(), "MCP-1", "report.txt", strings.NewReader("all good"))
+	assert.Nil(t, err)
+	assert.Len(t, attachments, 1)
+	assert.Equal(t, 2, resp.Attempts)
+}
+
+func TestAttachmentsServiceDownload(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/attachment/content/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, "all good")
+	})
+
+	body, _, err := client.Attachments.Download(context.Background(), "10000")
+	assert.Nil(t, err)
+	defer body.Close()
+
+	content, _ := ioutil.ReadAll(body)
+	assert.Equal(t, "all good", string(content))
+}
+
+func TestAttachmentsServiceDownloadRedirect(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	cdn := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _, ok := r.BasicAuth()
+		assert.False(t, ok)
+		fmt.Fprint(w, "from cdn")
+	}))
+	defer cdn.Close()
+
+	mux.HandleFunc("/api/2/attachment/content/10000", func(w http.ResponseWriter, r *http.Request) {
+		_, _, ok := r.BasicAuth()
+		assert.True(t, ok)
+		http.Redirect(w, r, "/api/2/attachment/content/10000/moved", http.StatusFound)
+	})
+	mux.HandleFunc("/api/2/attachment/content/10000/moved", func(w http.ResponseWriter, r *http.Request) {
+		_, _, ok := r.BasicAuth()
+		assert.True(t, ok)
+		http.Redirect(w, r, cdn.URL+"/file", http.StatusFound)
+	})
+
+	tp := &BasicAuthTransport{Username: "u", Password: "p"}
+	bac, _ := NewClient(defaultBaseURL, tp.Client())
+	bac.BaseURL = client.BaseURL
+
+	body, _, err := bac.Attachments.Download(context.Background(), "10000")
+	assert.Nil(t, err)
+	defer body.Close()
+
+	content, _ := ioutil.ReadAll(body)
+	assert.Equal(t, "from cdn", string(content))
+}
+
+func TestAttachmentsServiceDownloadNotFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/attachment/content/10000", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages": ["The attachment does not exist"]}`)
+	})
+
+	body, resp, err := client.Attachments.Download(context.Background(), "10000")
+	assert.Nil(t, body)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	jerr, ok := err.(*JiraError)
+	assert.True(t, ok)
+	assert.Equal(t, "The attachment does not exist", jerr.Messages[0])
+}
@@ -13,10 +13,15 @@ type BacklogService service
 
 // MoveIssuesTo move issues to the backlog.
 // This operation is equivalent to remove future and active sprints from a given set of issues.
-// At most 50 issues may be moved at once.
+// At most 50 issues may be moved at once, ErrTooManyIssues is returned without calling the
+// API when it is exceeded and ErrNoIssues when no issues are given.
 //
 // POST /rest/agile/1.0/backlog/issue
 func (b *BacklogService) MoveIssuesTo(ctx context.Context, issueKeys *IssueKeys) (bool, *Response, error) {
+	if err := issueKeys.validate(); err != nil {
+		return false, nil, err
+	}
+
 	req, err := b.client.NewRequest("POST", "backlog/issue", issueKeys)
 	if err != nil {
 		return false, nil, err
@@ -25,3 +25,15 @@ func TestBacklogServiceMoveIssuesTo(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, ok)
 }
+
+func TestBacklogServiceMoveIssuesToValidation(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Backlog.MoveIssuesTo(context.Background(), nil)
+	assert.Equal(t, ErrNoIssues, err)
+
+	tooMany := &IssueKeys{Issues: make([]string, MaxIssuesPerMove+1)}
+	_, _, err = client.Backlog.MoveIssuesTo(context.Background(), tooMany)
+	assert.Equal(t, ErrTooManyIssues, err)
+}
@@ -29,6 +29,8 @@ func (b *BoardsService) ListEpics(ctx context.Context, boardID int, opts *EpicsO
 	resp.MaxResults = wrap.MaxResults
 	resp.StartAt = wrap.StartAt
 	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
 
 	return wrap.Values, resp, nil
 }
@@ -59,6 +61,8 @@ func (b *BoardsService) ListIssuesForEpic(ctx context.Context, id int, epicID in
 	resp.MaxResults = wrap.MaxResults
 	resp.StartAt = wrap.StartAt
 	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
 
 	return wrap.Values, resp, nil
 }
@@ -88,6 +92,8 @@ func (b *BoardsService) ListIssuesWithoutEpic(ctx context.Context, id int, opts
 	resp.MaxResults = wrap.MaxResults
 	resp.StartAt = wrap.StartAt
 	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
 
 	return wrap.Values, resp, nil
 }
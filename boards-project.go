@@ -33,6 +33,8 @@ func (b *BoardsService) ListProjects(ctx context.Context, id int, opts *Projects
 	resp.MaxResults = wrap.MaxResults
 	resp.StartAt = wrap.StartAt
 	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
 
 	return wrap.Values, resp, nil
 }
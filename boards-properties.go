@@ -0,0 +1,142 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// ErrPropertyNotFound is matched, e.g. by errors.Is, by the *PropertyError returned by
+// BoardPropertiesService when the board has no property with the given key
+var ErrPropertyNotFound = errors.New("jira: property not found")
+
+// ErrInvalidPropertyKey is returned by BoardPropertiesService when the property key is rejected,
+// e.g. because it is empty or longer than 255 characters, either as is, when the key is checked
+// before calling the API, or matched by the *PropertyError returned when Jira rejects it
+var ErrInvalidPropertyKey = errors.New("jira: invalid property key")
+
+// PropertyError is returned by BoardPropertiesService when Jira responds that the property does
+// not exist, ErrPropertyNotFound, or that its key is invalid, ErrInvalidPropertyKey. The other
+// errors of the API, e.g. a missing board or an invalid value, are returned as *ErrorResponse.
+type PropertyError struct {
+	// Key is the key of the property
+	Key string
+	// Reason is either ErrPropertyNotFound or ErrInvalidPropertyKey
+	Reason error
+	Err    *ErrorResponse
+}
+
+func (e *PropertyError) Error() string {
+	return fmt.Sprintf("%v: %s: %v", e.Reason, e.Key, e.Err)
+}
+
+// Unwrap returns the error returned by the API
+func (e *PropertyError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is the Reason of the error
+func (e *PropertyError) Is(target error) bool {
+	return target == e.Reason
+}
+
+// maxPropertyKeyLen is the maximum length of a property key accepted by Jira
+const maxPropertyKeyLen = 255
+
+// BoardPropertiesService handles communication with the board property related
+// methods of the Jira Agile API. The properties of an epic are those of its issue,
+// see IssuePropertiesService.
+//
+// Jira Agile API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/board-getPropertiesKeys
+type BoardPropertiesService service
+
+// List returns the keys of the properties of a board, for a given board Id.
+//
+// GET /rest/agile/1.0/board/{boardId}/properties
+func (b *BoardPropertiesService) List(ctx context.Context, boardID int) ([]*EntityPropertyKey, *Response, error) {
+	return b.client.listProperties(ctx, fmt.Sprintf("board/%d/properties", boardID))
+}
+
+// Get returns a property of a board, for a given board Id and property key. A *PropertyError
+// matching ErrPropertyNotFound is returned when Jira responds 404 Not Found.
+//
+// GET /rest/agile/1.0/board/{boardId}/properties/{propertyKey}
+func (b *BoardPropertiesService) Get(ctx context.Context, boardID int, key string) (*EntityProperty, *Response, error) {
+	if err := validatePropertyKey(key); err != nil {
+		return nil, nil, err
+	}
+
+	property, resp, err := b.client.getProperty(ctx, fmt.Sprintf("board/%d/properties/%s", boardID, url.PathEscape(key)))
+	return property, resp, propertyError(err, key, true)
+}
+
+// Set sets the value of a property of a board, for a given board Id and property key. The value
+// is encoded to JSON, a json.RawMessage is sent as is. It returns whether the property was
+// created (201), rather than updated (200). ErrInvalidPropertyKey is matched by the error returned
+// when the key is rejected.
+//
+// PUT /rest/agile/1.0/board/{boardId}/properties/{propertyKey}
+func (b *BoardPropertiesService) Set(ctx context.Context, boardID int, key string, value interface{}) (bool, *Response, error) {
+	if err := validatePropertyKey(key); err != nil {
+		return false, nil, err
+	}
+
+	created, resp, err := b.client.setProperty(ctx, fmt.Sprintf("board/%d/properties/%s", boardID, url.PathEscape(key)), value)
+	return created, resp, propertyError(err, key, false)
+}
+
+// Delete deletes a property of a board, for a given board Id and property key. A *PropertyError
+// matching ErrPropertyNotFound is returned when Jira responds 404 Not Found.
+//
+// DELETE /rest/agile/1.0/board/{boardId}/properties/{propertyKey}
+func (b *BoardPropertiesService) Delete(ctx context.Context, boardID int, key string) (*Response, error) {
+	if err := validatePropertyKey(key); err != nil {
+		return nil, err
+	}
+
+	resp, err := b.client.deleteProperty(ctx, fmt.Sprintf("board/%d/properties/%s", boardID, url.PathEscape(key)))
+	return resp, propertyError(err, key, true)
+}
+
+// validatePropertyKey checks the length of a property key before calling the API
+func validatePropertyKey(key string) error {
+	if key == "" || len(key) > maxPropertyKeyLen {
+		return ErrInvalidPropertyKey
+	}
+	return nil
+}
+
+// propertyError wraps the errors returned by the API for the property of the given key in a
+// *PropertyError: 404, when notFound is set, for the requests of an existing property, and 400
+// when the error messages are about the key
+func propertyError(err error, key string, notFound bool) error {
+	errResp, ok := err.(*ErrorResponse)
+	if !ok {
+		return err
+	}
+
+	switch {
+	case errResp.StatusCode == http.StatusNotFound && notFound:
+		return &PropertyError{Key: key, Reason: ErrPropertyNotFound, Err: errResp}
+	case errResp.StatusCode == http.StatusBadRequest && propertyKeyRejected(errResp):
+		return &PropertyError{Key: key, Reason: ErrInvalidPropertyKey, Err: errResp}
+	}
+	return err
+}
+
+// propertyKeyRejected reports whether the error messages of errResp are about the property key
+func propertyKeyRejected(errResp *ErrorResponse) bool {
+	msgs := append([]string(nil), errResp.Messages...)
+	for field, msg := range errResp.Errors {
+		msgs = append(msgs, field, msg)
+	}
+	for _, msg := range msgs {
+		if strings.Contains(strings.ToLower(msg), "key") {
+			return true
+		}
+	}
+	return false
+}
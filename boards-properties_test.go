@@ -0,0 +1,140 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBoardPropertiesServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5/properties", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"keys": [{"key": "team.settings"},{"key": "wip.limits"}]}`)
+	})
+
+	keys, _, err := client.BoardProperties.List(context.Background(), 5)
+	assert.Nil(t, err)
+	assert.Len(t, keys, 2)
+	assert.Equal(t, "wip.limits", keys[1].Key)
+}
+
+func TestBoardPropertiesServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5/properties/wip.limits", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"key": "wip.limits","value": {"In Progress": 3}}`)
+	})
+	mux.HandleFunc("/board/5/properties/missing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	property, _, err := client.BoardProperties.Get(context.Background(), 5, "wip.limits")
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"In Progress": 3}`, string(property.Value))
+
+	_, resp, err := client.BoardProperties.Get(context.Background(), 5, "missing")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	propErr, ok := err.(*PropertyError)
+	if assert.True(t, ok) {
+		assert.True(t, propErr.Is(ErrPropertyNotFound))
+		assert.False(t, propErr.Is(ErrInvalidPropertyKey))
+		assert.Equal(t, "missing", propErr.Key)
+		assert.Equal(t, propErr.Err, propErr.Unwrap())
+	}
+}
+
+func TestBoardPropertiesServiceSet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5/properties/wip.limits", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"In Progress": 3}`, string(body))
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("/board/5/properties/bad", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages": ["The property key is invalid."]}`)
+	})
+	mux.HandleFunc("/board/5/properties/large", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages": ["The property value is too large."]}`)
+	})
+
+	created, _, err := client.BoardProperties.Set(context.Background(), 5, "wip.limits", map[string]int{"In Progress": 3})
+	assert.Nil(t, err)
+	assert.True(t, created)
+
+	_, _, err = client.BoardProperties.Set(context.Background(), 5, "bad", true)
+	propErr, ok := err.(*PropertyError)
+	if assert.True(t, ok) {
+		assert.True(t, propErr.Is(ErrInvalidPropertyKey))
+		assert.Equal(t, []string{"The property key is invalid."}, propErr.Err.Messages)
+	}
+
+	_, _, err = client.BoardProperties.Set(context.Background(), 5, "large", true)
+	errResp, ok := err.(*ErrorResponse)
+	if assert.True(t, ok) {
+		assert.Equal(t, []string{"The property value is too large."}, errResp.Messages)
+	}
+}
+
+func TestBoardPropertiesServiceInvalidKey(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.BoardProperties.Set(context.Background(), 5, strings.Repeat("k", 256), true)
+	assert.Equal(t, ErrInvalidPropertyKey, err)
+
+	_, _, err = client.BoardProperties.Get(context.Background(), 5, "")
+	assert.Equal(t, ErrInvalidPropertyKey, err)
+}
+
+func TestBoardPropertiesServiceDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5/properties/wip.limits", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.BoardProperties.Delete(context.Background(), 5, "wip.limits")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestBoardPropertiesServiceBoardNotFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/6/properties", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages": ["Board does not exist or you do not have permission to see it."]}`)
+	})
+	mux.HandleFunc("/board/6/properties/wip.limits", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages": ["Board does not exist or you do not have permission to see it."]}`)
+	})
+
+	_, _, err := client.BoardProperties.List(context.Background(), 6)
+	_, ok := err.(*ErrorResponse)
+	assert.True(t, ok)
+
+	_, _, err = client.BoardProperties.Set(context.Background(), 6, "wip.limits", true)
+	errResp, ok := err.(*ErrorResponse)
+	if assert.True(t, ok) {
+		assert.Equal(t, []string{"Board does not exist or you do not have permission to see it."}, errResp.Messages)
+	}
+}
@@ -7,9 +7,15 @@ import (
 
 // ListSprints returns all sprints from a board, for a given board ID.
 // This only includes sprints that the user has permission to view.
+// ErrInvalidSprintState is returned without calling the API when opts.State has an unknown state.
 //
 // GET /rest/agile/1.0/board/{boardId}/sprint
 func (b *BoardsService) ListSprints(ctx context.Context, id int, opts *SprintsOptions) ([]*Sprint, *Response, error) {
+	if opts != nil {
+		if err := validateSprintStates(opts.State); err != nil {
+			return nil, nil, err
+		}
+	}
 
 	q := QueryParameters(opts)
 
@@ -27,6 +33,8 @@ func (b *BoardsService) ListSprints(ctx context.Context, id int, opts *SprintsOp
 	resp.MaxResults = wrap.MaxResults
 	resp.StartAt = wrap.StartAt
 	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
 
 	return wrap.Values, resp, nil
 }
@@ -54,6 +62,8 @@ func (b *BoardsService) ListIssuesForSprint(ctx context.Context, id int, sprintI
 	resp.MaxResults = wrap.MaxResults
 	resp.StartAt = wrap.StartAt
 	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
 
 	return wrap.Values, resp, nil
 }
@@ -48,6 +48,31 @@ func TestBoardsServiceListSprints(t *testing.T) {
 	assert.False(t, resp.IsLast)
 }
 
+func TestBoardsServiceListSprintsByState(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5259/sprint", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "future,active", r.URL.Query().Get("state"))
+		assert.Equal(t, "10", r.URL.Query().Get("startAt"))
+		fmt.Fprint(w, `{"maxResults": 10,"startAt": 10,"isLast": true,
+		"values": [{"id": 5260,"state": "future","name": "Sprint 002"},{"id": 5261,"state": "active","name": "Sprint 003"}]}`)
+	})
+
+	opts := &SprintsOptions{
+		StartAt: 10,
+		State:   []SprintState{SprintStateFuture, SprintStateActive},
+	}
+
+	sprints, resp, err := client.Boards.ListSprints(context.Background(), 5259, opts)
+	assert.Nil(t, err)
+	assert.Len(t, sprints, 2)
+	assert.Equal(t, 10, resp.MaxResults)
+	assert.Equal(t, 10, resp.StartAt)
+	assert.True(t, resp.IsLast)
+}
+
 func TestBoardsServiceListIssuesForSprint(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -61,3 +86,11 @@ func TestBoardsServiceListIssuesForSprint(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Len(t, backlog, 1)
 }
+
+func TestBoardsServiceListSprintsInvalidState(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Boards.ListSprints(context.Background(), 5259, &SprintsOptions{State: []SprintState{SprintStateActive, "activ"}})
+	assert.Equal(t, ErrInvalidSprintState, err)
+}
@@ -31,6 +31,8 @@ func (b *BoardsService) ListVersions(ctx context.Context, id int, opts *Versions
 	resp.MaxResults = wrap.MaxResults
 	resp.StartAt = wrap.StartAt
 	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
 
 	return wrap.Values, resp, nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 )
 
 // BoardsService handles communication with the board related
@@ -27,6 +28,13 @@ type Board struct {
 	SelfLink string `json:"self,omitempty"`
 }
 
+// Board types, used by NewBoard.Type and BoardsOptions.Type
+const (
+	BoardTypeScrum  = "scrum"
+	BoardTypeKanban = "kanban"
+	BoardTypeSimple = "simple"
+)
+
 // NewBoard contains all options to create a board
 type NewBoard struct {
 	//Must be less than 255 characters.
@@ -127,6 +135,17 @@ func (s Configuration) String() string {
 	return s.Name
 }
 
+// RankCustomFieldID returns the id of the custom field used to rank the issues of
+// the board, as expected by EpicRank.RankCustomFieldID and IssueRank.RankCustomFieldID.
+// An empty string is returned when the ranking field is not defined.
+func (s Configuration) RankCustomFieldID() string {
+	if s.Ranking.CustomFieldID == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(s.Ranking.CustomFieldID)
+}
+
 // Create creates a new board. Board name, type and filter Id is required.
 //
 // POST /rest/agile/1.0/board
@@ -190,6 +209,8 @@ func (b *BoardsService) List(ctx context.Context, opts *BoardsOptions) ([]*Board
 	resp.MaxResults = wrap.MaxResults
 	resp.StartAt = wrap.StartAt
 	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
 
 	return wrap.Values, resp, nil
 }
@@ -240,6 +261,8 @@ func (b *BoardsService) ListBacklogIssues(ctx context.Context, id int, opts *Iss
 	resp.MaxResults = wrap.MaxResults
 	resp.StartAt = wrap.StartAt
 	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
 
 	return wrap.Values, resp, nil
 }
@@ -270,6 +293,8 @@ func (b *BoardsService) ListIssues(ctx context.Context, id int, opts *IssuesOpti
 	resp.MaxResults = wrap.MaxResults
 	resp.StartAt = wrap.StartAt
 	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
 
 	return wrap.Values, resp, nil
 }
@@ -67,6 +67,30 @@ func TestBoardsServiceList(t *testing.T) {
 	assert.False(t, resp.IsLast)
 }
 
+func TestBoardsServiceListByType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "kanban", r.URL.Query().Get("type"))
+		assert.Equal(t, "CBD", r.URL.Query().Get("projectKeyOrId"))
+		fmt.Fprint(w, `{"maxResults": 50,"startAt": 0,"isLast": true,
+		"values": [{"id": 43,"self": "https://jira.com/rest/agile/1.0/board/43","name": "CBD board","type": "kanban"}]}`)
+	})
+
+	opts := &BoardsOptions{
+		Type:           BoardTypeKanban,
+		ProjectKeyOrID: "CBD",
+	}
+
+	boards, resp, err := client.Boards.List(context.Background(), opts)
+	assert.Nil(t, err)
+	assert.Len(t, boards, 1)
+	assert.Equal(t, BoardTypeKanban, boards[0].Type)
+	assert.True(t, resp.IsLast)
+}
+
 func TestBoardsServiceGet(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -216,5 +240,7 @@ func TestBoardsServiceGetConfiguration(t *testing.T) {
 	assert.Equal(t, want.ID, configuration.ID)
 	assert.Equal(t, want.Name, configuration.Name)
 	assert.Equal(t, want.Estimation.Field.ID, configuration.Estimation.Field.ID)
+	assert.Equal(t, "10020", configuration.RankCustomFieldID())
+	assert.Equal(t, "", Configuration{}.RankCustomFieldID())
 
 }
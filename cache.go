@@ -0,0 +1,161 @@
+package jira
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// Cache stores the bodies of the responses to GET requests with their entity tag, see
+// WithResponseCache. The key is the requested URL, including the query string. Implementations
+// must be safe for concurrent use.
+type Cache interface {
+	// Get returns the response cached for the key, if any
+	Get(key string) (*CachedResponse, bool)
+	// Set caches the response for the key, replacing the previous one
+	Set(key string, r *CachedResponse)
+	// Delete removes the response cached for the key
+	Delete(key string)
+}
+
+// CachedResponse is a response stored in a Cache
+type CachedResponse struct {
+	// URL is the requested URL, including the query string
+	URL string
+	// ETag is the entity tag of the response
+	ETag string
+	// Body is the decompressed body of the response
+	Body []byte
+}
+
+// memoryCache is a Cache storing the responses in a map
+type memoryCache struct {
+	mu        sync.RWMutex
+	responses map[string]*CachedResponse
+}
+
+// NewMemoryCache returns a Cache keeping the responses in memory, without any size limit.
+func NewMemoryCache() Cache {
+	return &memoryCache{responses: make(map[string]*CachedResponse)}
+}
+
+func (m *memoryCache) Get(key string) (*CachedResponse, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	r, ok := m.responses[key]
+	return r, ok
+}
+
+func (m *memoryCache) Set(key string, r *CachedResponse) {
+	m.mu.Lock()
+	m.responses[key] = r
+	m.mu.Unlock()
+}
+
+func (m *memoryCache) Delete(key string) {
+	m.mu.Lock()
+	delete(m.responses, key)
+	m.mu.Unlock()
+}
+
+// cacheIndex indexes the keys of the responses cached by the client by the path of their URL, so
+// that a request changing a resource discards the responses to all its query variants
+type cacheIndex struct {
+	mu   sync.Mutex
+	keys map[string]map[string]struct{}
+}
+
+// add records the key of a response cached for the path
+func (x *cacheIndex) add(path, key string) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	if x.keys == nil {
+		x.keys = make(map[string]map[string]struct{})
+	}
+	if x.keys[path] == nil {
+		x.keys[path] = make(map[string]struct{})
+	}
+	x.keys[path][key] = struct{}{}
+}
+
+// take removes and returns the keys of the responses cached for the path
+func (x *cacheIndex) take(path string) []string {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+	keys := make([]string, 0, len(x.keys[path]))
+	for key := range x.keys[path] {
+		keys = append(keys, key)
+	}
+	delete(x.keys, path)
+	return keys
+}
+
+// conditionalRequest returns the request made conditional on the entity tag of the cached
+// response to the same URL, if any, with that response. Requests already conditional,
+// e.g. by WithIfNoneMatch, are left as is.
+func (c *Client) conditionalRequest(req *http.Request) (*http.Request, *CachedResponse) {
+	if c.cache == nil || req.Method != http.MethodGet || req.Header.Get("If-None-Match") != "" {
+		return req, nil
+	}
+
+	cached, ok := c.cache.Get(req.URL.String())
+	if !ok || cached.URL != req.URL.String() {
+		return req, nil
+	}
+
+	req2 := new(http.Request)
+	*req2 = *req
+	req2.Header = make(http.Header, len(req.Header)+1)
+	for k, s := range req.Header {
+		req2.Header[k] = append([]string(nil), s...)
+	}
+	req2.Header.Set("If-None-Match", cached.ETag)
+
+	return req2, cached
+}
+
+// updateCache caches the response to a GET request when it has an entity tag, or replaces
+// the body of a 304 Not Modified response by the cached one. The responses cached for the
+// URLs of the path of a request with any other method are discarded, since it may change
+// the resource.
+func (c *Client) updateCache(req *http.Request, resp *http.Response, response *Response, cached *CachedResponse) error {
+	if c.cache == nil {
+		return nil
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+	case http.MethodHead, http.MethodOptions:
+		return nil
+	default:
+		c.cache.Delete(req.URL.String())
+		for _, key := range c.cacheKeys.take(req.URL.Path) {
+			c.cache.Delete(key)
+		}
+		return nil
+	}
+
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		resp.Body = ioutil.NopCloser(bytes.NewReader(cached.Body))
+		response.NotModified = false
+		response.FromCache = true
+		if c.retainResponseBody {
+			response.RawBody = cached.Body
+		}
+		return nil
+	}
+
+	if etag := resp.Header.Get("ETag"); etag != "" && resp.StatusCode >= 200 && resp.StatusCode <= 299 {
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		key := req.URL.String()
+		c.cache.Set(key, &CachedResponse{URL: key, ETag: etag, Body: body})
+		c.cacheKeys.add(req.URL.Path, key)
+	}
+
+	return nil
+}
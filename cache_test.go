@@ -0,0 +1,151 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithResponseCache(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls, notModified int
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if r.Method != "GET" {
+			fmt.Fprint(w, `{"id": 5,"key": "MCP-5","name": "renamed"}`)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprint(w, `{"id": 5,"key": "MCP-5","name": "epic name"}`)
+	})
+
+	WithResponseCache(NewMemoryCache())(client)
+
+	epic, resp, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, "epic name", epic.Name)
+	assert.False(t, resp.FromCache)
+
+	epic, resp, err = client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, "epic name", epic.Name)
+	assert.True(t, resp.FromCache)
+	assert.False(t, resp.NotModified)
+	assert.Equal(t, 1, notModified)
+
+	_, _, err = client.Epics.PartiallyUpdate(context.Background(), "5", &Epic{Name: "renamed"})
+	assert.Nil(t, err)
+
+	_, resp, err = client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.False(t, resp.FromCache)
+	assert.Equal(t, 1, notModified)
+	assert.Equal(t, 4, calls)
+}
+
+func TestWithResponseCacheQuery(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"`+r.URL.Query().Get("startAt")+`"`)
+		if r.Header.Get("If-None-Match") != "" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprintf(w, `{"isLast": true,"issues": [{"key": "MCP-%s"}]}`, r.URL.Query().Get("startAt"))
+	})
+
+	WithResponseCache(NewMemoryCache())(client)
+
+	issues, _, err := client.Epics.ListIssues(context.Background(), "5", &IssuesOptions{StartAt: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-1", issues[0].Key)
+
+	issues, resp, err := client.Epics.ListIssues(context.Background(), "5", &IssuesOptions{StartAt: 2})
+	assert.Nil(t, err)
+	assert.False(t, resp.FromCache)
+	assert.Equal(t, "MCP-2", issues[0].Key)
+
+	issues, resp, err = client.Epics.ListIssues(context.Background(), "5", &IssuesOptions{StartAt: 2})
+	assert.Nil(t, err)
+	assert.True(t, resp.FromCache)
+	assert.Equal(t, "MCP-2", issues[0].Key)
+}
+
+func TestWithResponseCacheQueryInvalidation(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5/issue", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		w.Header().Set("ETag", `"`+r.URL.Query().Get("startAt")+`"`)
+		if r.Header.Get("If-None-Match") != "" {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprintf(w, `{"isLast": true,"issues": [{"key": "MCP-%s"}]}`, r.URL.Query().Get("startAt"))
+	})
+
+	WithResponseCache(NewMemoryCache())(client)
+
+	for _, startAt := range []int{1, 2} {
+		_, resp, err := client.Epics.ListIssues(context.Background(), "5", &IssuesOptions{StartAt: startAt})
+		assert.Nil(t, err)
+		assert.False(t, resp.FromCache)
+	}
+
+	// both query variants are cached
+	for _, startAt := range []int{1, 2} {
+		issues, resp, err := client.Epics.ListIssues(context.Background(), "5", &IssuesOptions{StartAt: startAt})
+		assert.Nil(t, err)
+		assert.True(t, resp.FromCache)
+		assert.Equal(t, fmt.Sprintf("MCP-%d", startAt), issues[0].Key)
+	}
+
+	_, _, err := client.Epics.MoveIssuesTo(context.Background(), "5", &IssueKeys{Issues: []string{"MCP-3"}})
+	assert.Nil(t, err)
+
+	// the move discarded both
+	for _, startAt := range []int{1, 2} {
+		_, resp, err := client.Epics.ListIssues(context.Background(), "5", &IssuesOptions{StartAt: startAt})
+		assert.Nil(t, err)
+		assert.False(t, resp.FromCache)
+	}
+}
+
+func TestWithResponseCacheCallerETag(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprint(w, `{"id": 5,"key": "MCP-5"}`)
+	})
+
+	WithResponseCache(NewMemoryCache())(client)
+
+	client.Epics.Get(context.Background(), "5")
+	epic, resp, err := client.Epics.Get(WithIfNoneMatch(context.Background(), `"v1"`), "5")
+	assert.Nil(t, err)
+	assert.Nil(t, epic)
+	assert.True(t, resp.NotModified)
+	assert.False(t, resp.FromCache)
+}
@@ -0,0 +1,29 @@
+package jira
+
+import "context"
+
+// Context returns the context of the client, canceled by Close. The requests sent with a context
+// derived from it, or with context.Background() or context.TODO(), which are replaced by it, are
+// canceled when the client is closed.
+func (c *Client) Context() context.Context {
+	return c.ctx
+}
+
+// clientContext returns the context of the client in place of the empty contexts
+func (c *Client) clientContext(ctx context.Context) context.Context {
+	if c.ctx != nil && (ctx == context.Background() || ctx == context.TODO()) {
+		return c.ctx
+	}
+	return ctx
+}
+
+// Close cancels the requests in flight sent with the context of the client, see Context, and
+// closes the idle connections of its http.Client. The requests sent afterwards with the context
+// of the client fail with context.Canceled. Close can be called more than once.
+func (c *Client) Close() error {
+	if c.cancel != nil {
+		c.cancel()
+	}
+	c.client.CloseIdleConnections()
+	return nil
+}
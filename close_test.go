@@ -0,0 +1,69 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientClose(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var dials int32
+	client.client.Transport = dialCountingTransport(&dials)
+
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":5}`)
+	})
+	mux.HandleFunc("/epic/6", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	})
+
+	_, _, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&dials))
+
+	errs := make(chan error)
+	go func() {
+		_, _, err := client.Epics.Get(context.Background(), "6")
+		errs <- err
+	}()
+	<-started
+	assert.Nil(t, client.Close())
+	assert.Equal(t, context.Canceled, <-errs)
+
+	_, _, err = client.Epics.Get(client.Context(), "5")
+	assert.Equal(t, context.Canceled, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	_, _, err = client.Epics.Get(ctx, "5")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&dials))
+
+	assert.Nil(t, client.Close())
+}
+
+func TestClientCloseDownload(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/attachment/content/10000", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "content")
+	})
+
+	assert.Nil(t, client.Close())
+	_, _, err := client.Attachments.Download(context.TODO(), "10000")
+	assert.Equal(t, context.Canceled, err)
+}
@@ -0,0 +1,123 @@
+package jira
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// flightGroup shares the response of a request among the identical requests sent while it is
+// in flight, see WithRequestCoalescing. Nothing is kept once the request is done.
+type flightGroup struct {
+	mu     sync.Mutex
+	flying map[string]*flight
+}
+
+// flight is a request in flight, resp and body are set when done is closed
+type flight struct {
+	done chan struct{}
+	resp *http.Response
+	body []byte
+	err  error
+}
+
+// do calls send once for the requests identified by key sent concurrently. shared reports
+// whether the result comes from a request sent by another caller. A caller waiting for the
+// request of another one stops when ctx is done.
+func (g *flightGroup) do(ctx context.Context, key string, send func() (*http.Response, []byte, error)) (resp *http.Response, body []byte, shared bool, err error) {
+	g.mu.Lock()
+	if f, ok := g.flying[key]; ok {
+		g.mu.Unlock()
+		select {
+		case <-f.done:
+			return f.resp, f.body, true, f.err
+		case <-ctx.Done():
+			return nil, nil, false, ctx.Err()
+		}
+	}
+	if g.flying == nil {
+		g.flying = make(map[string]*flight)
+	}
+	f := &flight{done: make(chan struct{})}
+	g.flying[key] = f
+	g.mu.Unlock()
+
+	f.resp, f.body, f.err = send()
+
+	g.mu.Lock()
+	delete(g.flying, key)
+	g.mu.Unlock()
+	close(f.done)
+
+	return f.resp, f.body, false, f.err
+}
+
+// send sends req by the HTTP client. If WithRequestCoalescing was used, a GET request is
+// shared with the identical ones in flight, each caller getting its own copy of the response.
+// The body of a shared response is limited as defined by WithMaxResponseBytes.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	if c.coalescing == nil || req.Method != http.MethodGet {
+		return c.client.Do(req)
+	}
+
+	resp, body, shared, err := c.coalescing.do(req.Context(), coalescingKey(req), func() (*http.Response, []byte, error) {
+		resp, err := c.client.Do(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer drainBody(resp.Body)
+
+		var r io.Reader = resp.Body
+		if c.maxResponseBytes > 0 {
+			r = &maxBytesReader{ReadCloser: resp.Body, n: c.maxResponseBytes}
+		}
+		body, err := ioutil.ReadAll(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp, body, nil
+	})
+	if err != nil {
+		if shared && err != ErrResponseTooLarge {
+			// the error of another caller, e.g. its context was canceled, is not reused
+			return c.client.Do(req)
+		}
+		return nil, err
+	}
+
+	resp2 := new(http.Response)
+	*resp2 = *resp
+	resp2.Header = make(http.Header, len(resp.Header))
+	for k, s := range resp.Header {
+		resp2.Header[k] = append([]string(nil), s...)
+	}
+	resp2.Body = ioutil.NopCloser(bytes.NewReader(body))
+	resp2.Request = req
+	return resp2, nil
+}
+
+// coalescingKey returns the key identifying the identical requests: the method, the URL and
+// all the headers, so that the requests differing by a conditional header such as
+// If-None-Match, by the headers of WithRequestHeaders or by their credentials or
+// impersonated user are not shared.
+func coalescingKey(req *http.Request) string {
+	keys := make([]string, 0, len(req.Header))
+	for k := range req.Header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	b.WriteString(req.Method + " " + req.URL.String())
+	for _, k := range keys {
+		for _, v := range req.Header[k] {
+			b.WriteString("\n" + k + ": " + v)
+		}
+	}
+	return b.String()
+}
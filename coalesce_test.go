@@ -0,0 +1,176 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestCoalescing(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRequestCoalescing()(client)
+
+	var calls int32
+	mux.HandleFunc("/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(50 * time.Millisecond)
+		fmt.Fprint(w, fieldsAsJSON)
+	})
+
+	results := make([][]*Field, 5)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			fields, _, err := client.Fields.List(context.Background())
+			assert.Nil(t, err)
+			results[i] = fields
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	results[0][0].Name = "changed"
+	for _, fields := range results[1:] {
+		assert.Len(t, fields, 2)
+		assert.Equal(t, "Summary", fields[0].Name)
+	}
+}
+
+func TestRequestCoalescingErrorNotKept(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRequestCoalescing()(client)
+
+	calls := 0
+	mux.HandleFunc("/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, fieldsAsJSON)
+	})
+
+	_, _, err := client.Fields.List(context.Background())
+	assert.NotNil(t, err)
+
+	fields, _, err := client.Fields.List(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, fields, 2)
+	assert.Equal(t, 2, calls)
+}
+
+func TestRequestCoalescingOnlyGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRequestCoalescing()(client)
+
+	var calls int32
+	mux.HandleFunc("/api/2/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := client.NewRequest("DELETE", client.apiPath("issue/MCP-1"), nil)
+			_, err := client.Do(context.Background(), req, nil)
+			assert.Nil(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestRequestCoalescingKeyHeaders(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRequestCoalescing()(client)
+
+	var calls int32
+	mux.HandleFunc("/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(50 * time.Millisecond)
+		fmt.Fprint(w, fieldsAsJSON)
+	})
+
+	var wg sync.WaitGroup
+	for _, user := range []string{"alice", "bob"} {
+		wg.Add(1)
+		go func(user string) {
+			defer wg.Done()
+			ctx := WithRequestHeaders(context.Background(), http.Header{"X-User": []string{user}})
+			_, _, err := client.Fields.List(ctx)
+			assert.Nil(t, err)
+		}(user)
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestRequestCoalescingWaiterContext(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRequestCoalescing()(client)
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	mux.HandleFunc("/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		fmt.Fprint(w, fieldsAsJSON)
+	})
+
+	leader := make(chan error)
+	go func() {
+		_, _, err := client.Fields.List(context.Background())
+		leader <- err
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, _, err := client.Fields.List(ctx)
+	assert.NotNil(t, err)
+	assert.True(t, time.Since(start) < time.Second)
+
+	close(release)
+	assert.Nil(t, <-leader)
+}
+
+func TestRequestCoalescingMaxResponseBytes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRequestCoalescing()(client)
+	assert.Nil(t, WithMaxResponseBytes(10)(client))
+
+	mux.HandleFunc("/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, fieldsAsJSON)
+	})
+
+	_, _, err := client.Fields.List(context.Background())
+	assert.Equal(t, ErrResponseTooLarge, err)
+}
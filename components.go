@@ -0,0 +1,103 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// ComponentsService handles communication with the project component related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/component
+type ComponentsService service
+
+// List returns all components of a project, for a given project Id or project key.
+//
+// GET /rest/api/2/project/{projectIdOrKey}/components
+func (c *ComponentsService) List(ctx context.Context, projectIDOrKey string) ([]*IssueComponent, *Response, error) {
+
+	req, err := c.client.NewRequest("GET", c.client.apiPath(fmt.Sprintf("project/%s/components", projectIDOrKey)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var components []*IssueComponent
+	resp, err := c.client.Do(ctx, req, &components)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return components, resp, nil
+}
+
+// Get returns a project component, for a given component Id.
+//
+// GET /rest/api/2/component/{id}
+func (c *ComponentsService) Get(ctx context.Context, id string) (*IssueComponent, *Response, error) {
+
+	req, err := c.client.NewRequest("GET", c.client.apiPath(fmt.Sprintf("component/%s", id)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var component = &IssueComponent{}
+	resp, err := c.client.Do(ctx, req, component)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return component, resp, nil
+}
+
+// Create creates a project component. The project is given by IssueComponent.Project or
+// IssueComponent.ProjectID. It returns the created component.
+//
+// POST /rest/api/2/component
+func (c *ComponentsService) Create(ctx context.Context, component *IssueComponent) (*IssueComponent, *Response, error) {
+
+	req, err := c.client.NewRequest("POST", c.client.apiPath("component"), component)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created = &IssueComponent{}
+	resp, err := c.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// Update updates a project component, for a given component Id. Only the fields set are
+// updated. It returns the updated component.
+//
+// PUT /rest/api/2/component/{id}
+func (c *ComponentsService) Update(ctx context.Context, id string, component *IssueComponent) (*IssueComponent, *Response, error) {
+
+	req, err := c.client.NewRequest("PUT", c.client.apiPath(fmt.Sprintf("component/%s", id)), component)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated = &IssueComponent{}
+	resp, err := c.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, nil
+}
+
+// Delete deletes a project component, for a given component Id.
+//
+// DELETE /rest/api/2/component/{id}
+func (c *ComponentsService) Delete(ctx context.Context, id string) (*Response, error) {
+
+	req, err := c.client.NewRequest("DELETE", c.client.apiPath(fmt.Sprintf("component/%s", id)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.client.Do(ctx, req, nil)
+}
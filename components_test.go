@@ -0,0 +1,96 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestComponentsServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/project/MCP/components", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `[{"id": "10000","name": "Backend","project": "MCP","projectId": 10000},{"id": "10001","name": "Frontend","project": "MCP","projectId": 10000}]`)
+	})
+
+	components, _, err := client.Components.List(context.Background(), "MCP")
+	assert.Nil(t, err)
+	assert.Len(t, components, 2)
+	assert.Equal(t, "Backend", components[0].Name)
+	assert.Equal(t, 10000, components[1].ProjectID)
+}
+
+func TestComponentsServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": "10000","name": "Backend","description": "Services and APIs","lead": {"accountId": "5b10a2844c20165700ede21g"},"assigneeType": "PROJECT_LEAD"}`)
+	})
+
+	component, _, err := client.Components.Get(context.Background(), "10000")
+	assert.Nil(t, err)
+	assert.Equal(t, "Services and APIs", component.Description)
+	assert.Equal(t, "5b10a2844c20165700ede21g", component.Lead.AccountID)
+	assert.Equal(t, "PROJECT_LEAD", component.AssigneeType)
+}
+
+func TestComponentsServiceCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/component", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"name": "Backend", "project": "MCP"}, body)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "10000","name": "Backend","project": "MCP"}`)
+	})
+
+	component, _, err := client.Components.Create(context.Background(), &IssueComponent{Name: "Backend", Project: "MCP"})
+	assert.Nil(t, err)
+	assert.Equal(t, "10000", component.ID)
+}
+
+func TestComponentsServiceUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"description": "Services and APIs"}, body)
+
+		fmt.Fprint(w, `{"id": "10000","name": "Backend","description": "Services and APIs"}`)
+	})
+
+	component, _, err := client.Components.Update(context.Background(), "10000", &IssueComponent{Description: "Services and APIs"})
+	assert.Nil(t, err)
+	assert.Equal(t, "Backend", component.Name)
+}
+
+func TestComponentsServiceDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/component/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Components.Delete(context.Background(), "10000")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
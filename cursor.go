@@ -0,0 +1,73 @@
+package jira
+
+import "errors"
+
+var (
+	// ErrInvalidCursor is returned when a PageCursor is malformed or belongs to another listing,
+	// e.g. to the epics of another board
+	ErrInvalidCursor = errors.New("jira: the cursor is invalid or belongs to another listing")
+	// ErrStaleCursor is returned when resuming from a PageCursor after the items before it have
+	// changed, e.g. deleted or reranked, so that resuming would skip or repeat items
+	ErrStaleCursor = errors.New("jira: the cursor is stale, the items before it have changed")
+)
+
+// PageCursor is the position of a paginated listing, e.g. of an EpicIterator or of the issues
+// listed by EpicsService.ListIssuesAll or SearchService.SearchJQLAll. It can be persisted, e.g. as
+// JSON, to resume the listing later without fetching the items already read, e.g. after a long job
+// was interrupted or a page failed, see EpicsService.IteratorFrom and IssuesOptions.Cursor.
+//
+// The listings paginated by offset hold the index of the next item, StartAt, and the id of the
+// last item read, LastID, while those paginated by token, e.g. SearchService.SearchJQLAll, hold the
+// token of the next page, NextPageToken.
+type PageCursor struct {
+	// Scope identifies the listing, so that the cursor cannot resume another one
+	Scope string `json:"scope"`
+	// StartAt is the index of the next item to read
+	StartAt int `json:"startAt"`
+	// NextPageToken is the token of the next page to read, for the listings paginated by token
+	NextPageToken string `json:"nextPageToken,omitempty"`
+	// LastID is the id of the last item read, at StartAt-1, checked when resuming to detect a stale cursor
+	LastID string `json:"lastId,omitempty"`
+	// Done reports whether all the items have been read
+	Done bool `json:"done,omitempty"`
+}
+
+// resume checks that the cursor, if any, belongs to the listing of the given scope, paginated by
+// token or by offset, a new cursor being given the scope
+func (c *PageCursor) resume(scope string, token bool) error {
+	if c == nil {
+		return nil
+	}
+	if *c == (PageCursor{}) {
+		c.Scope = scope
+		return nil
+	}
+
+	if c.Scope != scope || c.StartAt < 0 {
+		return ErrInvalidCursor
+	}
+	if token && (c.StartAt != 0 || c.LastID != "") {
+		return ErrInvalidCursor
+	}
+	if !token && (c.NextPageToken != "" || (c.StartAt == 0) != (c.LastID == "")) {
+		return ErrInvalidCursor
+	}
+	return nil
+}
+
+// advance moves the cursor, if any, after a page of a listing paginated by offset, given its
+// response and the id of its last item
+func (c *PageCursor) advance(resp *Response, lastID string) {
+	if c == nil || resp.pageLen == 0 {
+		return
+	}
+	c.StartAt = resp.StartAt + resp.pageLen
+	c.LastID = lastID
+}
+
+// finish marks the listing of the cursor, if any, as done
+func (c *PageCursor) finish() {
+	if c != nil {
+		c.Done = true
+	}
+}
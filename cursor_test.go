@@ -0,0 +1,41 @@
+package jira
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageCursorResume(t *testing.T) {
+	var nilCursor *PageCursor
+	assert.Nil(t, nilCursor.resume("epic/MCP-1/issue", false))
+
+	cursor := &PageCursor{}
+	assert.Nil(t, cursor.resume("epic/MCP-1/issue", false))
+	assert.Equal(t, "epic/MCP-1/issue", cursor.Scope)
+
+	tests := []struct {
+		Name   string
+		Cursor PageCursor
+		Token  bool
+		Err    error
+	}{
+		{Name: "offset", Cursor: PageCursor{Scope: "s", StartAt: 2, LastID: "MCP-2"}},
+		{Name: "offset done", Cursor: PageCursor{Scope: "s", StartAt: 2, LastID: "MCP-2", Done: true}},
+		{Name: "token", Cursor: PageCursor{Scope: "s", NextPageToken: "abc"}, Token: true},
+		{Name: "another scope", Cursor: PageCursor{Scope: "other", StartAt: 2, LastID: "MCP-2"}, Err: ErrInvalidCursor},
+		{Name: "no scope", Cursor: PageCursor{StartAt: 2, LastID: "MCP-2"}, Err: ErrInvalidCursor},
+		{Name: "negative start", Cursor: PageCursor{Scope: "s", StartAt: -1, LastID: "MCP-2"}, Err: ErrInvalidCursor},
+		{Name: "start without last id", Cursor: PageCursor{Scope: "s", StartAt: 2}, Err: ErrInvalidCursor},
+		{Name: "last id without start", Cursor: PageCursor{Scope: "s", LastID: "MCP-2"}, Err: ErrInvalidCursor},
+		{Name: "token in offset listing", Cursor: PageCursor{Scope: "s", NextPageToken: "abc"}, Err: ErrInvalidCursor},
+		{Name: "offset in token listing", Cursor: PageCursor{Scope: "s", StartAt: 2, LastID: "MCP-2"}, Token: true, Err: ErrInvalidCursor},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			cursor := test.Cursor
+			assert.Equal(t, test.Err, cursor.resume("s", test.Token))
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// Filters of DashboardsService.List
+const (
+	// DashboardFilterFavourite returns the dashboards marked as favourite by the user
+	DashboardFilterFavourite = "favourite"
+	// DashboardFilterMy returns the dashboards owned by the user
+	DashboardFilterMy = "my"
+)
+
+// DashboardsService handles communication with the dashboard related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/dashboard
+type DashboardsService service
+
+// Dashboard represents a Jira dashboard
+type Dashboard struct {
+	ID               string             `json:"id,omitempty"`
+	Name             string             `json:"name,omitempty"`
+	SelfLink         string             `json:"self,omitempty"`
+	Description      string             `json:"description,omitempty"`
+	ViewURL          string             `json:"view,omitempty"`
+	Owner            *IssueUser         `json:"owner,omitempty"`
+	IsFavourite      bool               `json:"isFavourite,omitempty"`
+	Popularity       int                `json:"popularity,omitempty"`
+	SharePermissions []*SharePermission `json:"sharePermissions,omitempty"`
+}
+
+// DashboardWrap represents the data returned by the API,
+// in addition to the dashboard information, paging data is returned
+type DashboardWrap struct {
+	Pagination
+	Dashboards []*Dashboard `json:"dashboards,omitempty"`
+}
+
+// DashboardsOptions contains all options to list dashboards
+type DashboardsOptions struct {
+	//The filter applied to the list of dashboards. Valid values: favourite, my. All dashboards visible to the user are returned when not set.
+	Filter string `query:"filter"`
+	//The index of the first dashboard to return (0-based)
+	StartAt int `query:"startAt"`
+	//The maximum number of dashboards to return per page. Default: 20.
+	MaxResults int `query:"maxResults"`
+}
+
+// List returns the dashboards visible to the user, a page at a time.
+//
+// GET /rest/api/2/dashboard
+func (d *DashboardsService) List(ctx context.Context, opts *DashboardsOptions) ([]*Dashboard, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := d.client.NewRequest("GET", d.client.apiPath(fmt.Sprintf("dashboard%s", q)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &DashboardWrap{}
+	resp, err := d.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.MaxResults = wrap.MaxResults
+	resp.StartAt = wrap.StartAt
+	resp.IsLast = wrap.StartAt+len(wrap.Dashboards) >= wrap.Total
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Dashboards)
+
+	return wrap.Dashboards, resp, nil
+}
+
+// Get returns a dashboard, for a given dashboard Id.
+//
+// GET /rest/api/2/dashboard/{id}
+func (d *DashboardsService) Get(ctx context.Context, id string) (*Dashboard, *Response, error) {
+
+	req, err := d.client.NewRequest("GET", d.client.apiPath(fmt.Sprintf("dashboard/%s", id)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var dashboard = &Dashboard{}
+	resp, err := d.client.Do(ctx, req, dashboard)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return dashboard, resp, nil
+}
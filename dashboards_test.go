@@ -0,0 +1,67 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDashboardsServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/dashboard", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "favourite", r.URL.Query().Get("filter"))
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 2,"total": 3,"dashboards": [
+			{"id": "10000","name": "System Dashboard","view": "https://jira.mycompany.com/secure/Dashboard.jspa?selectPageId=10000","isFavourite": true},
+			{"id": "20000","name": "Team Dashboard","sharePermissions": [{"type": "global"}]}
+		]}`)
+	})
+
+	dashboards, resp, err := client.Dashboards.List(context.Background(), &DashboardsOptions{Filter: DashboardFilterFavourite})
+	assert.Nil(t, err)
+	assert.Len(t, dashboards, 2)
+	assert.Equal(t, "System Dashboard", dashboards[0].Name)
+	assert.Equal(t, "https://jira.mycompany.com/secure/Dashboard.jspa?selectPageId=10000", dashboards[0].ViewURL)
+	assert.True(t, dashboards[0].IsFavourite)
+	assert.Equal(t, "global", dashboards[1].SharePermissions[0].Type)
+	assert.Equal(t, 2, resp.MaxResults)
+	assert.False(t, resp.IsLast)
+	assert.Equal(t, 2, resp.NextStartAt())
+	assert.Equal(t, 3, resp.Total)
+	assert.True(t, resp.KnownTotal())
+}
+
+func TestDashboardsServiceListLastPage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/dashboard", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt": 2,"maxResults": 2,"total": 3,"dashboards": [{"id": "30000","name": "Release Dashboard"}]}`)
+	})
+
+	_, resp, err := client.Dashboards.List(context.Background(), &DashboardsOptions{StartAt: 2, MaxResults: 2})
+	assert.Nil(t, err)
+	assert.True(t, resp.IsLast)
+	assert.False(t, resp.HasMore())
+}
+
+func TestDashboardsServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/dashboard/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": "10000","name": "System Dashboard","owner": {"accountId": "5b10a2844c20165700ede21g"},"popularity": 3}`)
+	})
+
+	dashboard, _, err := client.Dashboards.Get(context.Background(), "10000")
+	assert.Nil(t, err)
+	assert.Equal(t, "System Dashboard", dashboard.Name)
+	assert.Equal(t, "5b10a2844c20165700ede21g", dashboard.Owner.AccountID)
+	assert.Equal(t, 3, dashboard.Popularity)
+}
@@ -2,8 +2,15 @@ package jira
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 )
 
 // EpicsService handles communication with the epic related
@@ -30,6 +37,76 @@ type Epic struct {
 	Color    map[string]string `json:"color,omitempty"`
 }
 
+// EpicColorKey represents the key of an epic color
+type EpicColorKey string
+
+// Valid epic colors
+const (
+	EpicColor1 EpicColorKey = "color_1"
+	EpicColor2 EpicColorKey = "color_2"
+	EpicColor3 EpicColorKey = "color_3"
+	EpicColor4 EpicColorKey = "color_4"
+	EpicColor5 EpicColorKey = "color_5"
+	EpicColor6 EpicColorKey = "color_6"
+	EpicColor7 EpicColorKey = "color_7"
+	EpicColor8 EpicColorKey = "color_8"
+	EpicColor9 EpicColorKey = "color_9"
+)
+
+// ErrInvalidEpicColor is returned when the color key is not one of color_1 to color_9
+var ErrInvalidEpicColor = errors.New("jira: invalid epic color, valid values are color_1 to color_9")
+
+// SetColor sets the color of the epic, as expected by the API: {"key": "color_3"}.
+// ErrInvalidEpicColor is returned if the color is not one of EpicColor1 to EpicColor9.
+func (e *Epic) SetColor(color EpicColorKey) error {
+	if !color.valid() {
+		return ErrInvalidEpicColor
+	}
+
+	e.Color = map[string]string{"key": string(color)}
+	return nil
+}
+
+// valid reports whether the color is one of EpicColor1 to EpicColor9
+func (c EpicColorKey) valid() bool {
+	switch c {
+	case EpicColor1, EpicColor2, EpicColor3, EpicColor4, EpicColor5,
+		EpicColor6, EpicColor7, EpicColor8, EpicColor9:
+		return true
+	}
+	return false
+}
+
+// EpicUpdate contains the fields of an epic changed by EpicsService.Update. Unlike Epic, only
+// the fields set are sent, so a field can be set to its zero value, e.g. Done to false, see
+// Bool and String.
+type EpicUpdate struct {
+	Name    *string       `json:"name,omitempty"`
+	Summary *string       `json:"summary,omitempty"`
+	Done    *bool         `json:"done,omitempty"`
+	Color   *EpicColorKey `json:"-"`
+}
+
+// MarshalJSON implements the json.Marshaler interface, sending the color as expected by the
+// API: {"key": "color_3"}.
+func (u EpicUpdate) MarshalJSON() ([]byte, error) {
+	type update EpicUpdate
+	v := struct {
+		update
+		Color map[string]string `json:"color,omitempty"`
+	}{update: update(u)}
+	if u.Color != nil {
+		v.Color = map[string]string{"key": string(*u.Color)}
+	}
+	return json.Marshal(v)
+}
+
+// ErrNilEpic is returned by EpicsService.Create when no epic is given
+var ErrNilEpic = errors.New("jira: the epic to create is required")
+
+// ErrEmptyEpicUpdate is returned by EpicsService.Update when the update sets no field
+var ErrEmptyEpicUpdate = errors.New("jira: the epic update sets no field")
+
 // EpicRank contains the fields for ranking epics
 type EpicRank struct {
 	RankAfter         string `json:"rankAfterEpic,omitempty"`
@@ -37,18 +114,115 @@ type EpicRank struct {
 	RankCustomFieldID string `json:"rankCustomFieldId,omitempty"`
 }
 
+// ErrInvalidRank is returned by EpicsService.Rank when the epic is not ranked relative to exactly one other epic
+var ErrInvalidRank = errors.New("jira: an epic must be ranked either before or after another epic")
+
+// validate checks that the epic, for the given id or key, is ranked relative to exactly one other epic
+func (r *EpicRank) validate(idOrKey string) error {
+	if r == nil || (r.RankBefore == "") == (r.RankAfter == "") {
+		return ErrInvalidRank
+	}
+	if r.RankBefore == idOrKey || r.RankAfter == idOrKey {
+		return ErrInvalidRank
+	}
+	return nil
+}
+
+// EpicIssueType is the name of the issue type of the epics
+const EpicIssueType = "Epic"
+
 // EpicsOptions contains all options to list all epics from the board
 type EpicsOptions struct {
 	//The starting index of the returned epics. Base index: 0. See the 'Pagination' section at the top of this page for more details.
 	StartAt int `query:"startAt"`
 	//The maximum number of epics to return per page. Default: 50. See the 'Pagination' section at the top of this page for more details.
 	MaxResults int `query:"maxResults"`
-	//Filters results to epics that are either done or not done. Valid values: true, false.
-	Done bool `query:"done"`
+	//Filters results to epics that are either done or not done. Valid values: true, false. If nil, the filter is not applied.
+	Done *bool `query:"done"`
+	//Reports the progress of EpicsService.ListAll after each page, see Progress. It is not sent to the API.
+	Progress Progress `query:"-"`
+}
+
+// SetEpicNameFieldID defines the id of the custom field used to store the epic name,
+// e.g. customfield_10011. This field varies per Jira instance and is required by Create.
+func (e *EpicsService) SetEpicNameFieldID(id string) {
+	e.client.epicNameFieldID = id
+}
+
+// epicNameFieldType is the schema of the custom field used to store the epic name
+const epicNameFieldType = "com.pyxis.greenhopper.jira:gh-epic-label"
+
+// EpicNameFieldID returns the id of the custom field used to store the epic name. It is the
+// one defined by SetEpicNameFieldID or, if none, the one found among the fields listed by
+// FieldsService, which are cached (see WithFieldCache). ErrFieldNotFound is returned when
+// there is no such field.
+func (e *EpicsService) EpicNameFieldID(ctx context.Context) (string, error) {
+	if e.client.epicNameFieldID != "" {
+		return e.client.epicNameFieldID, nil
+	}
+
+	fields, err := e.client.Fields.cached(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, field := range fields {
+		if field.Schema != nil && field.Schema.Custom == epicNameFieldType {
+			return field.ID, nil
+		}
+	}
+
+	return "", ErrFieldNotFound
+}
+
+// Create creates a new epic in the project, for the given project key. Epics are created as
+// issues of the Epic type, the summary is required and the name is stored in the epic name
+// custom field (see EpicNameFieldID), unless the instance has no such field, e.g. in the
+// team-managed projects. The summary defaults to the name when it is empty.
+// The returned epic contains the id and the key of the created issue.
+//
+// POST /rest/api/2/issue
+func (e *EpicsService) Create(ctx context.Context, projectKey string, epic *Epic) (*Epic, *Response, error) {
+	if epic == nil {
+		return nil, nil, ErrNilEpic
+	}
+
+	summary := epic.Summary
+	if summary == "" {
+		summary = epic.Name
+	}
+
+	issueReq := NewIssueRequest(projectKey, EpicIssueType, summary)
+	if epic.Name != "" {
+		fieldID, err := e.EpicNameFieldID(ctx)
+		switch err {
+		case nil:
+			issueReq.SetField(fieldID, epic.Name)
+		case ErrFieldNotFound:
+		default:
+			return nil, nil, err
+		}
+	}
+
+	issue, resp, err := e.client.Issues.Create(ctx, issueReq)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	created := *epic
+	created.Key = issue.Key
+	created.Summary = summary
+	if id, err := strconv.Atoi(issue.ID); err == nil {
+		created.ID = id
+	}
+
+	return &created, resp, nil
 }
 
 // Get returns the epic for a given epic Id.
 // This epic will only be returned if the user has permission to view it.
+// When the epic has not changed since the entity tag given by WithIfNoneMatch, no epic is
+// returned and Response.NotModified is set.
 //
 // GET /rest/agile/1.0/epic/{epicIdOrKey}
 func (e *EpicsService) Get(ctx context.Context, idOrKey string) (*Epic, *Response, error) {
@@ -60,7 +234,7 @@ func (e *EpicsService) Get(ctx context.Context, idOrKey string) (*Epic, *Respons
 
 	var epic = &Epic{}
 	resp, err := e.client.Do(ctx, req, epic)
-	if err != nil {
+	if err != nil || resp.NotModified {
 		return nil, resp, err
 	}
 
@@ -70,12 +244,15 @@ func (e *EpicsService) Get(ctx context.Context, idOrKey string) (*Epic, *Respons
 // ListIssues returns all issues that belong to the epic, for the given epic Id. This only includes
 // issues that the user has permission to view. Issues returned from this resource include Agile
 // fields, like sprint, closedSprints, flagged, and epic. By default, the returned issues are
-// ordered by rank.
+// ordered by rank, see IssuesOptions.OrderBy. ErrEpicJQLConflict is returned when the JQL
+// filters the issues by epic.
 //
 // GET /rest/agile/1.0/epic/{epicIdOrKey}/issue
 func (e *EpicsService) ListIssues(ctx context.Context, idOrKey string, opts *IssuesOptions) ([]*Issue, *Response, error) {
-
-	q := QueryParameters(opts)
+	q, err := epicIssuesQuery(opts)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := e.client.NewRequest("GET", fmt.Sprintf("epic/%s/issue%s", idOrKey, q), nil)
 	if err != nil {
@@ -91,12 +268,70 @@ func (e *EpicsService) ListIssues(ctx context.Context, idOrKey string, opts *Iss
 	resp.MaxResults = wrap.MaxResults
 	resp.StartAt = wrap.StartAt
 	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
 
 	return wrap.Values, resp, nil
 }
 
+// CountIssues returns the number of issues that belong to the epic, for a given epic Id or key,
+// and match opts.JQL, if any, without fetching the issues: only their total is requested, with
+// maxResults=0 and fields=key. When the Agile endpoint does not return the total, the issues are
+// counted with a JQL search, see SearchService.Search, matching the issues of the endpoint: those
+// linked to the epic, by the Epic Link field or as its children, except the epic itself and the
+// sub-tasks. opts.StartAt, MaxResults and Fields are ignored.
+//
+// GET /rest/agile/1.0/epic/{epicIdOrKey}/issue
+func (e *EpicsService) CountIssues(ctx context.Context, idOrKey string, opts *IssuesOptions) (int, *Response, error) {
+	var o IssuesOptions
+	if opts != nil {
+		o = *opts
+	}
+	o.StartAt, o.MaxResults, o.Fields = 0, 0, []string{"key"}
+
+	q, err := epicIssuesQuery(&o)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	req, err := e.client.NewRequest("GET", fmt.Sprintf("epic/%s/issue%s&maxResults=0", idOrKey, q), nil)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var count struct {
+		Total *int `json:"total"`
+	}
+	resp, err := e.client.Do(ctx, req, &count)
+	if err != nil || resp.NotModified {
+		return 0, resp, err
+	}
+	if count.Total != nil {
+		resp.Total = *count.Total
+		return *count.Total, resp, nil
+	}
+
+	key := `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(idOrKey) + `"`
+	jql := `("Epic Link" = ` + key + ` OR parent = ` + key + `) AND issuetype not in subTaskIssueTypes() AND key != ` + key
+	filter := strings.TrimSpace(o.JQL)
+	if loc := orderByRegexp.FindStringIndex(filter); loc != nil {
+		filter = strings.TrimSpace(filter[:loc[0]])
+	}
+	if filter != "" {
+		jql += " AND (" + filter + ")"
+	}
+
+	result, resp, err := e.client.Search.Search(ctx, jql, &SearchOptions{MaxResults: 1, Fields: []string{"key"}})
+	if err != nil {
+		return 0, resp, err
+	}
+
+	return result.Total, resp, nil
+}
+
 // PartiallyUpdate performs a partial update of the epic. A partial update means that fields not present
-// in the request JSON will not be updated. Valid values for color are color_1 to color_9.
+// in the request JSON will not be updated. Valid values for color are color_1 to color_9. The zero
+// values, e.g. Done false, are omitted, see Update to set them.
 //
 // POST /rest/agile/1.0/epic/{epicIdOrKey}
 func (e *EpicsService) PartiallyUpdate(ctx context.Context, idOrKey string, epic *Epic) (*Epic, *Response, error) {
@@ -114,14 +349,55 @@ func (e *EpicsService) PartiallyUpdate(ctx context.Context, idOrKey string, epic
 	return updatedEpic, resp, nil
 }
 
+// Update performs a partial update of the epic, for a given epic Id or key, sending only the
+// fields set in the update, so that, unlike PartiallyUpdate, a field can be set to its zero
+// value. ErrEmptyEpicUpdate is returned when no field is set and ErrInvalidEpicColor when the
+// color is not one of EpicColor1 to EpicColor9, without calling the API.
+//
+// POST /rest/agile/1.0/epic/{epicIdOrKey}
+func (e *EpicsService) Update(ctx context.Context, idOrKey string, update *EpicUpdate) (*Epic, *Response, error) {
+	if update == nil || *update == (EpicUpdate{}) {
+		return nil, nil, ErrEmptyEpicUpdate
+	}
+	if update.Color != nil && !update.Color.valid() {
+		return nil, nil, ErrInvalidEpicColor
+	}
+
+	req, err := e.client.NewRequest("POST", fmt.Sprintf("epic/%s", idOrKey), update)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updatedEpic = &Epic{}
+	resp, err := e.client.Do(ctx, req, updatedEpic)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updatedEpic, resp, nil
+}
+
+// SetDone marks the epic as done or not done, for a given epic Id or key, partially updating
+// only its done field, see Update.
+//
+// POST /rest/agile/1.0/epic/{epicIdOrKey}
+func (e *EpicsService) SetDone(ctx context.Context, idOrKey string, done bool) (*Epic, *Response, error) {
+	return e.Update(ctx, idOrKey, &EpicUpdate{Done: Bool(done)})
+}
+
 // MoveIssuesTo moves issues to an epic, for a given epic id. Issues can be only in a single epic
 // at the same time. That means that already assigned issues to an epic, will not be assigned to
 // the previous epic anymore. The user needs to have the edit issue permission for all issue
 // they want to move and to the epic. The maximum number of issues that can be moved in one
-// operation is 50.
+// operation is 50, ErrTooManyIssues is returned without calling the API when it is exceeded
+// and ErrNoIssues when no issues are given.
 //
 // POST /rest/agile/1.0/epic/{epicIdOrKey}/issue
 func (e *EpicsService) MoveIssuesTo(ctx context.Context, idOrKey string, issueKeys *IssueKeys) (bool, *Response, error) {
+	if err := issueKeys.validate(); err != nil {
+		return false, nil, err
+	}
+
 	req, err := e.client.NewRequest("POST", fmt.Sprintf("epic/%s/issue", idOrKey), issueKeys)
 	if err != nil {
 		return false, nil, err
@@ -139,14 +415,159 @@ func (e *EpicsService) MoveIssuesTo(ctx context.Context, idOrKey string, issueKe
 	return false, resp, nil
 }
 
+// MoveResult represents the outcome of MoveIssuesToVerified, the keys of the issues found
+// in the epic after the move and of those that are not, e.g. because the user cannot edit them
+type MoveResult struct {
+	Moved    []string
+	NotMoved []string
+}
+
+// MoveIssuesToVerified moves issues to an epic, as MoveIssuesTo does, for a given epic id or key,
+// then gets each issue to check that it landed in the epic, since Jira may skip the issues the
+// user cannot edit while still reporting success. The issues that cannot be found afterwards are
+// reported as not moved. The epic "none" checks that the issues no longer belong to any epic.
+// The returned response is the one of the move.
+//
+// POST /rest/agile/1.0/epic/{epicIdOrKey}/issue
+// GET /rest/agile/1.0/issue/{issueIdOrKey}
+func (e *EpicsService) MoveIssuesToVerified(ctx context.Context, idOrKey string, keys []string) (*MoveResult, *Response, error) {
+	_, resp, err := e.MoveIssuesTo(ctx, idOrKey, &IssueKeys{Issues: keys})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	result := &MoveResult{}
+	for _, key := range keys {
+		issue, r, err := e.client.Issues.Get(ctx, key, &GetIssueOptions{Fields: "epic"})
+		if err != nil {
+			if r != nil && r.StatusCode == http.StatusNotFound {
+				result.NotMoved = append(result.NotMoved, key)
+				continue
+			}
+			return result, r, err
+		}
+
+		if inEpic(issue, idOrKey) {
+			result.Moved = append(result.Moved, key)
+		} else {
+			result.NotMoved = append(result.NotMoved, key)
+		}
+	}
+
+	return result, resp, nil
+}
+
+// inEpic reports whether the issue belongs to the epic, given by id or key, or to no epic
+// when it is "none"
+func inEpic(issue *Issue, idOrKey string) bool {
+	var epic *Epic
+	if issue.Fields != nil {
+		epic = issue.Fields.Epic
+	}
+
+	if idOrKey == "none" {
+		return epic == nil
+	}
+
+	return epic != nil && (strconv.Itoa(epic.ID) == idOrKey || strings.EqualFold(epic.Key, idOrKey))
+}
+
+// MoveIssuesToBatched moves any number of issues to an epic, for a given epic id, splitting
+// the issue keys in groups of 50 moved sequentially by MoveIssuesTo. It returns the number
+// of issues moved, if a group fails, the number of issues moved so far is returned with the
+// error. The returned response is the one of the last group.
+//
+// POST /rest/agile/1.0/epic/{epicIdOrKey}/issue
+func (e *EpicsService) MoveIssuesToBatched(ctx context.Context, idOrKey string, keys []string) (int, *Response, error) {
+	if len(keys) == 0 {
+		return 0, nil, ErrNoIssues
+	}
+
+	var moved int
+	var resp *Response
+	for start := 0; start < len(keys); start += MaxIssuesPerMove {
+		select {
+		case <-ctx.Done():
+			return moved, resp, ctx.Err()
+		default:
+		}
+
+		end := start + MaxIssuesPerMove
+		if end > len(keys) {
+			end = len(keys)
+		}
+
+		ok, r, err := e.MoveIssuesTo(ctx, idOrKey, &IssueKeys{Issues: keys[start:end]})
+		if r != nil {
+			resp = r
+		}
+		if err != nil {
+			return moved, resp, err
+		}
+
+		if ok {
+			moved += end - start
+		}
+	}
+
+	return moved, resp, nil
+}
+
+// ErrEpicJQLConflict is returned when the JQL used to list the issues of an epic filters them by epic,
+// which is already done by the endpoint
+var ErrEpicJQLConflict = errors.New("jira: the JQL can not filter the issues by epic, the endpoint already does")
+
+// ErrOrderByConflict is returned when both IssuesOptions.JQL and OrderBy order the issues
+var ErrOrderByConflict = errors.New("jira: the JQL already orders the issues, OrderBy can not be used")
+
+var (
+	orderByRegexp    = regexp.MustCompile(`(?i)\border\s+by\b`)
+	epicClauseRegexp = regexp.MustCompile(`(?i)("epic link"|'epic link'|\bparentEpic\b|\bparent\b|\bepic\b)\s*(!?=|~|\bin\b|\bnot\s+in\b|\bis\b)`)
+)
+
+// epicIssuesQuery returns the query parameters for the issues of an epic, the JQL, if any, ending by
+// the OrderBy clause
+func epicIssuesQuery(opts *IssuesOptions) (string, error) {
+	if opts == nil {
+		return "", nil
+	}
+
+	jql := strings.TrimSpace(opts.JQL)
+	if epicClauseRegexp.MatchString(jql) {
+		return "", ErrEpicJQLConflict
+	}
+	if orderBy := strings.TrimSpace(opts.OrderBy); orderBy != "" {
+		if orderByRegexp.MatchString(jql) {
+			return "", ErrOrderByConflict
+		}
+		jql = strings.TrimSpace(jql + " ORDER BY " + orderBy)
+	}
+
+	o := *opts
+	o.JQL = ""
+	q := QueryParameters(&o)
+	if jql == "" {
+		return q, nil
+	}
+	if q == "" {
+		q = "?"
+	} else {
+		q += "&"
+	}
+	return q + "jql=" + url.QueryEscape(jql), nil
+}
+
 // ListIssuesWithoutEpic returns all issues that do not belong to any epic. This only includes issues
 // that the user has permission to view. Issues returned from this resource include Agile fields,
-// like sprint, closedSprints, flagged, and epic. By default, the returned issues are ordered by rank.
+// like sprint, closedSprints, flagged, and epic. By default, the returned issues are ordered by rank,
+// see IssuesOptions.OrderBy.
 //
 // GET /rest/agile/1.0/epic/none/issue
 func (e *EpicsService) ListIssuesWithoutEpic(ctx context.Context, opts *IssuesOptions) ([]*Issue, *Response, error) {
-
-	q := QueryParameters(opts)
+	q, err := epicIssuesQuery(opts)
+	if err != nil {
+		return nil, nil, err
+	}
 
 	req, err := e.client.NewRequest("GET", "epic/none/issue"+q, nil)
 	if err != nil {
@@ -162,13 +583,15 @@ func (e *EpicsService) ListIssuesWithoutEpic(ctx context.Context, opts *IssuesOp
 	resp.MaxResults = wrap.MaxResults
 	resp.StartAt = wrap.StartAt
 	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
 
 	return wrap.Values, resp, nil
 }
 
 // RemoveIssuesFrom removes issues from epics. The user needs to have the edit issue permission for
 // all issue they want to remove from epics. The maximum number of issues that can be moved in one
-// operation is 50.
+// operation is 50, see MoveIssuesTo.
 //
 // POST /rest/agile/1.0/epic/none/issue
 func (e *EpicsService) RemoveIssuesFrom(ctx context.Context, issueKeys *IssueKeys) (bool, *Response, error) {
@@ -177,9 +600,14 @@ func (e *EpicsService) RemoveIssuesFrom(ctx context.Context, issueKeys *IssueKey
 
 // Rank moves (ranks) an epic before or after a given epic.
 // If rankCustomFieldId is not defined, the default rank field will be used.
+// Exactly one of RankBefore and RankAfter must be set to an epic other than the
+// ranked one, ErrInvalidRank is returned without calling the API otherwise.
 //
 // PUT /rest/agile/1.0/epic/{epicIdOrKey}/rank
 func (e *EpicsService) Rank(ctx context.Context, idOrKey string, rank *EpicRank) (bool, *Response, error) {
+	if err := rank.validate(idOrKey); err != nil {
+		return false, nil, err
+	}
 
 	req, err := e.client.NewRequest("PUT", fmt.Sprintf("epic/%s/rank", idOrKey), rank)
 	if err != nil {
@@ -197,3 +625,477 @@ func (e *EpicsService) Rank(ctx context.Context, idOrKey string, rank *EpicRank)
 
 	return false, resp, nil
 }
+
+// RankError is returned by RankBatch when an epic cannot be ranked
+type RankError struct {
+	// Key is the id or key of the epic that could not be ranked
+	Key string
+	Err error
+}
+
+func (e *RankError) Error() string {
+	return fmt.Sprintf("jira: ranking epic %s: %v", e.Key, e.Err)
+}
+
+// Unwrap returns the error of the Rank call
+func (e *RankError) Unwrap() error {
+	return e.Err
+}
+
+// RankBatch ranks the epics in the given order, for the given epic ids or keys, each epic
+// being ranked after its predecessor by Rank, so ranking n epics takes n-1 calls. If an epic
+// cannot be ranked, a *RankError holding its key is returned and the epics after it are not
+// ranked. The returned response is the one of the last call.
+//
+// PUT /rest/agile/1.0/epic/{epicIdOrKey}/rank
+func (e *EpicsService) RankBatch(ctx context.Context, ordered []string, rankCustomFieldID string) (*Response, error) {
+	var resp *Response
+	for i := 1; i < len(ordered); i++ {
+		select {
+		case <-ctx.Done():
+			return resp, ctx.Err()
+		default:
+		}
+
+		rank := &EpicRank{RankAfter: ordered[i-1], RankCustomFieldID: rankCustomFieldID}
+		_, r, err := e.Rank(ctx, ordered[i], rank)
+		if r != nil {
+			resp = r
+		}
+		if err != nil {
+			return resp, &RankError{Key: ordered[i], Err: err}
+		}
+	}
+
+	return resp, nil
+}
+
+// ListAll returns all epics from the board, for the given board ID, following the
+// pagination until the last page is reached. StartAt is advanced by the number of
+// epics returned in each page and MaxResults is used as the page size, a zero value
+// means the default page size of the client, see WithDefaultPageSize. If a page fails, the epics fetched so far are returned
+// along with the error. The returned response contains the pagination data of the
+// last page.
+//
+// GET /rest/agile/1.0/board/{boardId}/epic
+func (e *EpicsService) ListAll(ctx context.Context, boardID int, opts *EpicsOptions) ([]*Epic, *Response, error) {
+	var o EpicsOptions
+	if opts != nil {
+		o = *opts
+	}
+	o.MaxResults = e.client.pageSize(o.MaxResults)
+
+	var all []*Epic
+	for {
+		epics, resp, err := e.client.Boards.ListEpics(ctx, boardID, &o)
+		if err != nil {
+			return all, resp, err
+		}
+
+		if all == nil && resp.KnownTotal() {
+			all = make([]*Epic, 0, resp.prealloc())
+		}
+		all = append(all, epics...)
+		o.Progress.report(len(all), resp)
+
+		if resp.IsLast || len(epics) == 0 {
+			return all, resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return all, resp, ctx.Err()
+		default:
+		}
+
+		o.StartAt = resp.StartAt + len(epics)
+	}
+}
+
+// ListDone returns all the done epics from the board, for the given board ID, following the
+// pagination as ListAll.
+//
+// GET /rest/agile/1.0/board/{boardId}/epic?done=true
+func (e *EpicsService) ListDone(ctx context.Context, boardID int) ([]*Epic, *Response, error) {
+	return e.ListAll(ctx, boardID, &EpicsOptions{Done: Bool(true)})
+}
+
+// ListNotDone returns all the epics not done from the board, for the given board ID,
+// following the pagination as ListAll.
+//
+// GET /rest/agile/1.0/board/{boardId}/epic?done=false
+func (e *EpicsService) ListNotDone(ctx context.Context, boardID int) ([]*Epic, *Response, error) {
+	return e.ListAll(ctx, boardID, &EpicsOptions{Done: Bool(false)})
+}
+
+// ErrEpicNotFound is returned by EpicsService.GetByName when no epic of the board has the given name
+var ErrEpicNotFound = errors.New("jira: epic not found")
+
+// ErrMultipleEpics is returned by EpicsService.GetByName when several epics of the board have the given name
+var ErrMultipleEpics = errors.New("jira: more than one epic has the given name")
+
+// GetByName returns the epic of the board whose name is exactly the given one, for the given
+// board ID, listing all epics of the board, see ListAll. ErrEpicNotFound is returned when no
+// epic has the name and ErrMultipleEpics when more than one has it.
+//
+// GET /rest/agile/1.0/board/{boardId}/epic
+func (e *EpicsService) GetByName(ctx context.Context, boardID int, name string) (*Epic, *Response, error) {
+	return e.getByName(ctx, boardID, func(n string) bool { return n == name })
+}
+
+// GetByNameFold returns the epic of the board whose name matches the given one, ignoring case,
+// as GetByName does.
+//
+// GET /rest/agile/1.0/board/{boardId}/epic
+func (e *EpicsService) GetByNameFold(ctx context.Context, boardID int, name string) (*Epic, *Response, error) {
+	return e.getByName(ctx, boardID, func(n string) bool { return strings.EqualFold(n, name) })
+}
+
+// getByName returns the only epic of the board whose name matches
+func (e *EpicsService) getByName(ctx context.Context, boardID int, match func(string) bool) (*Epic, *Response, error) {
+	epics, resp, err := e.ListAll(ctx, boardID, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var found *Epic
+	for _, epic := range epics {
+		if !match(epic.Name) {
+			continue
+		}
+		if found != nil {
+			return nil, resp, ErrMultipleEpics
+		}
+		found = epic
+	}
+
+	if found == nil {
+		return nil, resp, ErrEpicNotFound
+	}
+
+	return found, resp, nil
+}
+
+// ListIssuesAll returns all issues of the epic, for a given epic Id or key, following the
+// pagination until the last page is reached, see ListIssues. StartAt is advanced by the
+// number of issues returned in each page, which is at most the MaxResults returned by the
+// server. If a page fails, the issues fetched so far are returned along with the error.
+// The returned response contains the pagination data of the last page. When opts.Dedup
+// is set, the issues found in more than one page are only returned once and the number
+// of duplicates dropped is set in Response.Duplicates.
+// The pages can be requested concurrently, see WithPageConcurrency.
+// The listing can be resumed from opts.Cursor, see IssuesOptions.Cursor.
+//
+// GET /rest/agile/1.0/epic/{epicIdOrKey}/issue
+func (e *EpicsService) ListIssuesAll(ctx context.Context, idOrKey string, opts *IssuesOptions) ([]*Issue, *Response, error) {
+	return listIssuesAll(ctx, e.client, "epic/"+idOrKey+"/issue", opts, func(ctx context.Context, o *IssuesOptions) ([]*Issue, *Response, error) {
+		return e.ListIssues(ctx, idOrKey, o)
+	})
+}
+
+// ListIssuesWithoutEpicAll returns all issues that do not belong to any epic, following the
+// pagination as ListIssuesAll does, see ListIssuesWithoutEpic.
+//
+// GET /rest/agile/1.0/epic/none/issue
+func (e *EpicsService) ListIssuesWithoutEpicAll(ctx context.Context, opts *IssuesOptions) ([]*Issue, *Response, error) {
+	return listIssuesAll(ctx, e.client, "epic/none/issue", opts, e.ListIssuesWithoutEpic)
+}
+
+// listIssuesAll calls list for each page of issues until the last one. Once the first page
+// tells the total number of issues, the next ones are listed concurrently when enabled by
+// WithPageConcurrency. The listing of the issues of the given path is resumed from o.Cursor,
+// which is advanced after each page.
+func listIssuesAll(ctx context.Context, c *Client, path string, opts *IssuesOptions, list func(context.Context, *IssuesOptions) ([]*Issue, *Response, error)) ([]*Issue, *Response, error) {
+	var o IssuesOptions
+	if opts != nil {
+		o = *opts
+	}
+	o.MaxResults = c.pageSize(o.MaxResults)
+
+	cursor := o.Cursor
+	scope := fmt.Sprintf("%s?jql=%s&orderBy=%s", path, url.QueryEscape(o.JQL), url.QueryEscape(o.OrderBy))
+	if err := cursor.resume(scope, false); err != nil {
+		return nil, nil, err
+	}
+	var verify string
+	if cursor != nil {
+		if cursor.Done {
+			return nil, nil, nil
+		}
+		o.StartAt, verify = cursor.StartAt, cursor.LastID
+	}
+
+	var all []*Issue
+	var seen map[string]bool
+	if o.Dedup {
+		seen = make(map[string]bool)
+	}
+	var duplicates, fetched int
+	add := func(issues []*Issue, resp *Response) {
+		fetched += len(issues)
+		defer o.Progress.report(fetched, resp)
+		if n := len(issues); n > 0 {
+			cursor.advance(resp, issues[n-1].Key)
+		}
+		for _, issue := range issues {
+			if seen != nil {
+				if seen[issue.Key] {
+					duplicates++
+					continue
+				}
+				seen[issue.Key] = true
+			}
+			all = append(all, issue)
+		}
+	}
+
+	for {
+		if verify != "" {
+			// the first page also gets the last issue read before the cursor
+			o.StartAt--
+		}
+		issues, resp, err := list(ctx, &o)
+		if resp != nil {
+			resp.Duplicates = duplicates
+		}
+		if err != nil {
+			return all, resp, err
+		}
+		if verify != "" {
+			if len(issues) == 0 || issues[0].Key != verify {
+				return all, resp, ErrStaleCursor
+			}
+			issues, verify = issues[1:], ""
+		}
+
+		if all == nil && resp.KnownTotal() {
+			all = make([]*Issue, 0, resp.prealloc())
+		}
+		add(issues, resp)
+		resp.Duplicates = duplicates
+
+		if c.pageConcurrency > 1 && resp.HasMore() && resp.KnownTotal() && resp.Total > resp.NextStartAt() {
+			last, err := listIssuePages(ctx, c.pageConcurrency, o, resp, list, add)
+			if last != nil {
+				last.Duplicates = duplicates
+			}
+			if err != nil {
+				return all, last, err
+			}
+			resp = last
+		}
+
+		if !resp.HasMore() {
+			cursor.finish()
+			return all, resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return all, resp, ctx.Err()
+		default:
+		}
+
+		o.StartAt = resp.NextStartAt()
+	}
+}
+
+// listIssuePages lists the pages of issues after the one of first, up to its total, with at
+// most concurrency requests at a time. The pages are given to add in order, by the calling
+// goroutine, as soon as they and the previous ones are listed, and the response of the last one
+// is returned. If a page fails, the others are canceled and the pages listed before the first
+// canceled or failed one are given to add, the response and error of the failed one being returned.
+func listIssuePages(ctx context.Context, concurrency int, o IssuesOptions, first *Response, list func(context.Context, *IssuesOptions) ([]*Issue, *Response, error), add func([]*Issue, *Response)) (*Response, error) {
+	size := first.pageLen
+	var starts []int
+	for start := first.NextStartAt(); start < first.Total; start += size {
+		starts = append(starts, start)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := make([][]*Issue, len(starts))
+	resps := make([]*Response, len(starts))
+	errs := make([]error, len(starts))
+	done := make([]chan struct{}, len(starts))
+	sem := make(chan struct{}, concurrency)
+
+	var mu sync.Mutex
+	var firstErr error
+	var firstResp *Response
+	var wg sync.WaitGroup
+	for i, start := range starts {
+		wg.Add(1)
+		done[i] = make(chan struct{})
+		go func(i, start int) {
+			defer wg.Done()
+			defer close(done[i])
+
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+
+			po := o
+			po.StartAt = start
+			po.MaxResults = size
+			pages[i], resps[i], errs[i] = list(ctx, &po)
+			if errs[i] != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr, firstResp = errs[i], resps[i]
+				}
+				mu.Unlock()
+				cancel()
+			}
+		}(i, start)
+	}
+	defer wg.Wait()
+
+	for i := range starts {
+		<-done[i]
+		if errs[i] != nil {
+			cancel()
+			wg.Wait()
+			if firstErr != nil {
+				return firstResp, firstErr
+			}
+			return resps[i], errs[i]
+		}
+		add(pages[i], resps[i])
+	}
+
+	return resps[len(resps)-1], nil
+}
+
+// EpicIterator iterates over the epics of a board without loading all of them
+// in memory. The pages are fetched lazily, the next one is only requested when
+// all epics of the current page have been read.
+type EpicIterator struct {
+	service *EpicsService
+	boardID int
+	opts    EpicsOptions
+	epics   []*Epic
+	resp    *Response
+	done    bool
+	// last is the id of the last epic returned by Next, or of the one before the cursor
+	last int
+	// verify reports whether the first page must start by the last epic, when resuming
+	// from a cursor
+	verify bool
+}
+
+// Iterator returns an iterator over the epics from the board, for the given board ID.
+// The page size can be defined by opts.MaxResults, a zero value means the default page
+// size of the client, see WithDefaultPageSize.
+//
+// GET /rest/agile/1.0/board/{boardId}/epic
+func (e *EpicsService) Iterator(boardID int, opts *EpicsOptions) *EpicIterator {
+	it := &EpicIterator{
+		service: e,
+		boardID: boardID,
+	}
+	if opts != nil {
+		it.opts = *opts
+	}
+	it.opts.MaxResults = e.client.pageSize(it.opts.MaxResults)
+
+	return it
+}
+
+// IteratorFrom returns an iterator over the epics from the board, for the given board ID, resuming
+// from the cursor of a previous iterator, see EpicIterator.Cursor, with the same options. The page
+// size can differ. ErrInvalidCursor is returned when the cursor is malformed or belongs to another
+// board or options. The first page also gets the epic before the cursor: when it is not the last
+// epic read, e.g. because epics were deleted, Next returns ErrStaleCursor.
+//
+// GET /rest/agile/1.0/board/{boardId}/epic
+func (e *EpicsService) IteratorFrom(boardID int, opts *EpicsOptions, cursor PageCursor) (*EpicIterator, error) {
+	it := e.Iterator(boardID, opts)
+	if cursor.Scope != it.scope() || cursor.StartAt < 0 || cursor.NextPageToken != "" || (cursor.StartAt == 0) != (cursor.LastID == "") {
+		return nil, ErrInvalidCursor
+	}
+	if cursor.LastID != "" {
+		last, err := strconv.Atoi(cursor.LastID)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+		it.last, it.verify = last, !cursor.Done
+	}
+	it.opts.StartAt = cursor.StartAt
+	it.done = cursor.Done
+
+	return it, nil
+}
+
+// scope returns the scope of the cursors of the iterator, identifying the board and filter
+func (it *EpicIterator) scope() string {
+	done := ""
+	if it.opts.Done != nil {
+		done = strconv.FormatBool(*it.opts.Done)
+	}
+	return fmt.Sprintf("board/%d/epic?done=%s", it.boardID, done)
+}
+
+// Cursor returns the position of the iterator, from which a new iterator can resume, see
+// EpicsService.IteratorFrom. The epics read from the current page are not read again.
+func (it *EpicIterator) Cursor() PageCursor {
+	cursor := PageCursor{
+		Scope:   it.scope(),
+		StartAt: it.opts.StartAt - len(it.epics),
+		Done:    it.done && len(it.epics) == 0,
+	}
+	if cursor.StartAt > 0 {
+		cursor.LastID = strconv.Itoa(it.last)
+	}
+	return cursor
+}
+
+// Next returns the next epic. When there are no more epics, ErrNoMoreItems is returned.
+func (it *EpicIterator) Next(ctx context.Context) (*Epic, error) {
+	for len(it.epics) == 0 {
+		if it.done {
+			return nil, ErrNoMoreItems
+		}
+
+		opts := it.opts
+		if it.verify {
+			opts.StartAt--
+		}
+		epics, resp, err := it.service.client.Boards.ListEpics(ctx, it.boardID, &opts)
+		if resp != nil {
+			it.resp = resp
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if it.verify && (len(epics) == 0 || epics[0].ID != it.last) {
+			return nil, ErrStaleCursor
+		}
+
+		it.opts.StartAt = resp.StartAt + len(epics)
+		it.done = resp.IsLast || len(epics) == 0
+		if it.verify {
+			// drops the last epic read before the cursor
+			epics = epics[1:]
+			it.verify = false
+		}
+		it.epics = epics
+	}
+
+	epic := it.epics[0]
+	it.epics = it.epics[1:]
+	it.last = epic.ID
+
+	return epic, nil
+}
+
+// Response returns the response of the last page requested by the iterator.
+func (it *EpicIterator) Response() *Response {
+	return it.resp
+}
@@ -2,10 +2,17 @@ package jira
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/url"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -128,6 +135,188 @@ func TestEpicsServiceListIssuesWithoutEpic(t *testing.T) {
 	assert.Len(t, backlog, 1)
 }
 
+func TestEpicsServiceListIssuesOrderBy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-5/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "status = Done ORDER BY created DESC", r.URL.Query().Get("jql"))
+		assert.Equal(t, "10", r.URL.Query().Get("maxResults"))
+		_, _ = fmt.Fprint(w, issuesAsJSON)
+	})
+
+	issues, _, err := client.Epics.ListIssues(context.Background(), "MCP-5", &IssuesOptions{MaxResults: 10, JQL: "status = Done", OrderBy: "created DESC"})
+	assert.Nil(t, err)
+	assert.Len(t, issues, 1)
+}
+
+func TestEpicsServiceListIssuesWithoutEpicOrderBy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/none/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "ORDER BY priority DESC, key", r.URL.Query().Get("jql"))
+		_, _ = fmt.Fprint(w, issuesAsJSON)
+	})
+
+	issues, _, err := client.Epics.ListIssuesWithoutEpic(context.Background(), &IssuesOptions{OrderBy: "priority DESC, key"})
+	assert.Nil(t, err)
+	assert.Len(t, issues, 1)
+}
+
+func TestEpicsServiceListIssuesFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-5/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "fields=summary,status&jql=status+%3D+Done", r.URL.RawQuery)
+		_, _ = fmt.Fprint(w, `{"issues":[{"key":"MCP-6","fields":{"summary":"Sum","status":{"name":"Done"}}}]}`)
+	})
+
+	issues, _, err := client.Epics.ListIssues(context.Background(), "MCP-5", &IssuesOptions{JQL: "status = Done", Fields: []string{"summary", "status"}})
+	assert.Nil(t, err)
+	assert.Len(t, issues, 1)
+	assert.Equal(t, "Sum", issues[0].Fields.Summary)
+}
+
+func TestEpicsServiceListIssuesEmptyFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-5/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "", r.URL.RawQuery)
+		_, _ = fmt.Fprint(w, issuesAsJSON)
+	})
+
+	_, _, err := client.Epics.ListIssues(context.Background(), "MCP-5", &IssuesOptions{Fields: []string{}})
+	assert.Nil(t, err)
+}
+
+// benchmarkDecodeIssues decodes a page of 50 issues, each one encoded as issue
+func benchmarkDecodeIssues(b *testing.B, issue string) {
+	page := []byte(`{"maxResults":50,"total":50,"issues":[` + strings.TrimSuffix(strings.Repeat(issue+",", 50), ",") + `]}`)
+	b.SetBytes(int64(len(page)))
+	b.ReportAllocs()
+	for n := 0; n < b.N; n++ {
+		var wrap IssueWrap
+		if err := json.Unmarshal(page, &wrap); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeIssuesAllFields(b *testing.B) {
+	benchmarkDecodeIssues(b, issueAsJSON)
+}
+
+func BenchmarkDecodeIssuesSummaryStatus(b *testing.B) {
+	benchmarkDecodeIssues(b, `{"id":"10001","key":"MCP-1","fields":{"summary":"Summary","status":{"id":"1","name":"Open"}}}`)
+}
+
+func TestEpicsServiceCountIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-5/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "fields=key&jql=status+%3D+Done&maxResults=0", r.URL.RawQuery)
+		_, _ = fmt.Fprint(w, `{"startAt":0,"maxResults":0,"total":42,"issues":[]}`)
+	})
+	mux.HandleFunc("/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("search must not be called")
+	})
+
+	count, resp, err := client.Epics.CountIssues(context.Background(), "MCP-5", &IssuesOptions{JQL: "status = Done", MaxResults: 10, StartAt: 5})
+	assert.Nil(t, err)
+	assert.Equal(t, 42, count)
+	assert.Equal(t, 42, resp.Total)
+}
+
+func TestEpicsServiceCountIssuesEmpty(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-5/issue", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"startAt":0,"maxResults":0,"total":0,"issues":[]}`)
+	})
+	mux.HandleFunc("/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("search must not be called")
+	})
+
+	count, _, err := client.Epics.CountIssues(context.Background(), "MCP-5", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, count)
+}
+
+func TestEpicsServiceCountIssuesSearchFallback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-5/issue", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = fmt.Fprint(w, `{"issues":[]}`)
+	})
+	mux.HandleFunc("/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `("Epic Link" = "MCP-5" OR parent = "MCP-5") AND issuetype not in subTaskIssueTypes() AND key != "MCP-5" AND (status = Done)`, r.URL.Query().Get("jql"))
+		assert.Equal(t, "1", r.URL.Query().Get("maxResults"))
+		assert.Equal(t, "key", r.URL.Query().Get("fields"))
+		_, _ = fmt.Fprint(w, `{"startAt":0,"maxResults":1,"total":7,"issues":[{"key":"MCP-6"}]}`)
+	})
+
+	count, _, err := client.Epics.CountIssues(context.Background(), "MCP-5", &IssuesOptions{JQL: "status = Done ORDER BY created"})
+	assert.Nil(t, err)
+	assert.Equal(t, 7, count)
+}
+
+func TestEpicsServiceListIssuesInvalidJQL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-5/issue", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("invalid JQL sent to the API")
+	})
+
+	_, _, err := client.Epics.ListIssues(context.Background(), "MCP-5", &IssuesOptions{JQL: `"Epic Link" = MCP-6`})
+	assert.Equal(t, ErrEpicJQLConflict, err)
+
+	_, _, err = client.Epics.ListIssues(context.Background(), "MCP-5", &IssuesOptions{JQL: "parent in (MCP-6)"})
+	assert.Equal(t, ErrEpicJQLConflict, err)
+
+	_, _, err = client.Epics.ListIssues(context.Background(), "MCP-5", &IssuesOptions{JQL: "project = MCP order by rank", OrderBy: "created"})
+	assert.Equal(t, ErrOrderByConflict, err)
+}
+
+func TestEpicIssuesQuery(t *testing.T) {
+	q, err := epicIssuesQuery(&IssuesOptions{JQL: `issuetype = Epic AND "Epic Name" ~ "x"`})
+	assert.Nil(t, err)
+	assert.Equal(t, "?jql="+url.QueryEscape(`issuetype = Epic AND "Epic Name" ~ "x"`), q)
+
+	q, err = epicIssuesQuery(&IssuesOptions{StartAt: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, "?startAt=2", q)
+
+	q, err = epicIssuesQuery(&IssuesOptions{StartAt: 2, JQL: "  "})
+	assert.Nil(t, err)
+	assert.Equal(t, "?startAt=2", q)
+
+	q, err = epicIssuesQuery(nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "", q)
+}
+
+func TestEpicsServiceListIssuesJQL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-5/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "jql=sprint+in+openSprints%28%29+AND+resolution+is+EMPTY", r.URL.RawQuery)
+		_, _ = fmt.Fprint(w, issuesAsJSON)
+	})
+
+	issues, _, err := client.Epics.ListIssues(context.Background(), "MCP-5", &IssuesOptions{JQL: " sprint in openSprints() AND resolution is EMPTY "})
+	assert.Nil(t, err)
+	assert.Len(t, issues, 1)
+}
+
 func TestEpicsServiceRank(t *testing.T) {
 	client, mux, _, teardown := setup()
 	defer teardown()
@@ -145,3 +334,895 @@ func TestEpicsServiceRank(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, ok)
 }
+
+func TestEpicsServiceRankInvalid(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/1/rank", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("invalid rank sent to the API")
+	})
+
+	for _, rank := range []*EpicRank{
+		nil,
+		{},
+		{RankCustomFieldID: "customfield_10019"},
+		{RankBefore: "9", RankAfter: "8"},
+		{RankBefore: "1"},
+		{RankAfter: "1"},
+	} {
+		ok, resp, err := client.Epics.Rank(context.Background(), "1", rank)
+		assert.Equal(t, ErrInvalidRank, err)
+		assert.False(t, ok)
+		assert.Nil(t, resp)
+	}
+}
+
+func TestEpicsServiceListAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5/epic", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"maxResults": 2,"startAt": 0,"isLast": false,"values": [{"id": 1,"key": "MCP-1"},{"id": 2,"key": "MCP-2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"maxResults": 2,"startAt": 2,"isLast": true,"values": [{"id": 3,"key": "MCP-3"}]}`)
+		default:
+			t.Errorf("unexpected startAt %q", r.URL.Query().Get("startAt"))
+		}
+	})
+
+	epics, resp, err := client.Epics.ListAll(context.Background(), 5, &EpicsOptions{MaxResults: 2})
+	assert.Nil(t, err)
+	assert.Len(t, epics, 3)
+	assert.Equal(t, "MCP-3", epics[2].Key)
+	assert.Equal(t, 2, resp.StartAt)
+	assert.True(t, resp.IsLast)
+}
+
+func TestEpicsServiceUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-5", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"name": "Renamed","done": false,"color": {"key": "color_4"}}`, string(body))
+		fmt.Fprint(w, `{"id": 5,"key": "MCP-5","name": "Renamed","color": {"key": "color_4"}}`)
+	})
+
+	color := EpicColor4
+	epic, _, err := client.Epics.Update(context.Background(), "MCP-5", &EpicUpdate{Name: String("Renamed"), Done: Bool(false), Color: &color})
+	assert.Nil(t, err)
+	assert.Equal(t, "Renamed", epic.Name)
+	assert.False(t, epic.Done)
+}
+
+func TestEpicsServiceUpdateInvalid(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-5", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("invalid update sent to the API")
+	})
+
+	_, _, err := client.Epics.Update(context.Background(), "MCP-5", &EpicUpdate{})
+	assert.Equal(t, ErrEmptyEpicUpdate, err)
+
+	_, _, err = client.Epics.Update(context.Background(), "MCP-5", nil)
+	assert.Equal(t, ErrEmptyEpicUpdate, err)
+
+	color := EpicColorKey("red")
+	_, _, err = client.Epics.Update(context.Background(), "MCP-5", &EpicUpdate{Color: &color})
+	assert.Equal(t, ErrInvalidEpicColor, err)
+}
+
+func TestEpicUpdateMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(&EpicUpdate{Summary: String("")})
+	assert.Nil(t, err)
+	assert.JSONEq(t, `{"summary": ""}`, string(b))
+}
+
+func TestEpicsServiceListDone(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5/epic", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("done") {
+		case "true":
+			fmt.Fprint(w, `{"isLast": true,"values": [{"id": 1,"key": "MCP-1","done": true}]}`)
+		case "false":
+			fmt.Fprint(w, `{"isLast": true,"values": [{"id": 2,"key": "MCP-2"},{"id": 3,"key": "MCP-3"}]}`)
+		default:
+			t.Errorf("unexpected done %q", r.URL.Query().Get("done"))
+		}
+	})
+
+	epics, _, err := client.Epics.ListDone(context.Background(), 5)
+	assert.Nil(t, err)
+	assert.Len(t, epics, 1)
+	assert.True(t, epics[0].Done)
+
+	epics, _, err = client.Epics.ListNotDone(context.Background(), 5)
+	assert.Nil(t, err)
+	assert.Len(t, epics, 2)
+}
+
+func TestEpicsServiceSetDone(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-5", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"done": body["done"]}, body)
+		fmt.Fprintf(w, `{"id": 5,"key": "MCP-5","done": %v}`, body["done"])
+	})
+
+	epic, _, err := client.Epics.SetDone(context.Background(), "MCP-5", true)
+	assert.Nil(t, err)
+	assert.True(t, epic.Done)
+
+	epic, _, err = client.Epics.SetDone(context.Background(), "MCP-5", false)
+	assert.Nil(t, err)
+	assert.False(t, epic.Done)
+}
+
+func TestEpicsServiceListAllProgressUnknownTotal(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5/epic", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"maxResults": 2,"startAt": 0,"values": [{"id": 1},{"id": 2}]}`)
+		case "2":
+			fmt.Fprint(w, `{"maxResults": 2,"startAt": 2,"isLast": true,"values": [{"id": 3}]}`)
+		}
+	})
+
+	var progress [][2]int
+	_, _, err := client.Epics.ListAll(context.Background(), 5, &EpicsOptions{Progress: func(fetched, total int) {
+		progress = append(progress, [2]int{fetched, total})
+	}})
+	assert.Nil(t, err)
+	assert.Equal(t, [][2]int{{2, -1}, {3, -1}}, progress)
+}
+
+func TestEpicsServiceListAllPartialResults(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/5/epic", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("startAt") == "1" {
+			http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `{"maxResults": 1,"startAt": 0,"isLast": false,"values": [{"id": 1,"key": "MCP-1"}]}`)
+	})
+
+	epics, resp, err := client.Epics.ListAll(context.Background(), 5, nil)
+	assert.NotNil(t, err)
+	assert.Len(t, epics, 1)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+}
+
+func TestEpicsServiceIterator(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/board/5/epic", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "2", r.URL.Query().Get("maxResults"))
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"maxResults": 2,"startAt": 0,"isLast": false,"values": [{"id": 1,"key": "MCP-1"},{"id": 2,"key": "MCP-2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"maxResults": 2,"startAt": 2,"isLast": true,"values": [{"id": 3,"key": "MCP-3"}]}`)
+		}
+	})
+
+	it := client.Epics.Iterator(5, &EpicsOptions{MaxResults: 2})
+	assert.Nil(t, it.Response())
+
+	var keys []string
+	for {
+		epic, err := it.Next(context.Background())
+		if err == ErrNoMoreItems {
+			break
+		}
+		assert.Nil(t, err)
+		keys = append(keys, epic.Key)
+
+		if epic.Key == "MCP-2" {
+			assert.Equal(t, 1, requests)
+		}
+	}
+
+	assert.Equal(t, []string{"MCP-1", "MCP-2", "MCP-3"}, keys)
+	assert.Equal(t, 2, requests)
+	assert.True(t, it.Response().IsLast)
+}
+
+// epicsPageHandler serves the epics with the given ids, a page at a time
+func epicsPageHandler(ids *[]int, startAts *[]int) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		maxResults, _ := strconv.Atoi(r.URL.Query().Get("maxResults"))
+		*startAts = append(*startAts, startAt)
+
+		if startAt > len(*ids) {
+			startAt = len(*ids)
+		}
+		end := startAt + maxResults
+		if end > len(*ids) {
+			end = len(*ids)
+		}
+		var values []string
+		for _, id := range (*ids)[startAt:end] {
+			values = append(values, fmt.Sprintf(`{"id":%d,"key":"MCP-%d"}`, id, id))
+		}
+		fmt.Fprintf(w, `{"maxResults":%d,"startAt":%d,"isLast":%t,"values":[%s]}`, maxResults, startAt, end == len(*ids), strings.Join(values, ","))
+	}
+}
+
+func TestEpicsServiceIteratorFrom(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ids, startAts := []int{1, 2, 3, 4, 5}, []int(nil)
+	mux.HandleFunc("/board/5/epic", epicsPageHandler(&ids, &startAts))
+
+	done := false
+	it := client.Epics.Iterator(5, &EpicsOptions{MaxResults: 2, Done: &done})
+	assert.Equal(t, PageCursor{Scope: "board/5/epic?done=false"}, it.Cursor())
+	for i := 0; i < 3; i++ {
+		_, err := it.Next(context.Background())
+		assert.Nil(t, err)
+	}
+
+	data, err := json.Marshal(it.Cursor())
+	assert.Nil(t, err)
+	var cursor PageCursor
+	assert.Nil(t, json.Unmarshal(data, &cursor))
+	assert.Equal(t, PageCursor{Scope: "board/5/epic?done=false", StartAt: 3, LastID: "3"}, cursor)
+
+	startAts = nil
+	it, err = client.Epics.IteratorFrom(5, &EpicsOptions{MaxResults: 2, Done: &done}, cursor)
+	assert.Nil(t, err)
+	assert.Equal(t, cursor, it.Cursor())
+
+	var keys []string
+	for {
+		epic, err := it.Next(context.Background())
+		if err == ErrNoMoreItems {
+			break
+		}
+		assert.Nil(t, err)
+		keys = append(keys, epic.Key)
+	}
+	assert.Equal(t, []string{"MCP-4", "MCP-5"}, keys)
+	assert.Equal(t, []int{2, 4}, startAts)
+	assert.Equal(t, PageCursor{Scope: "board/5/epic?done=false", StartAt: 5, LastID: "5", Done: true}, it.Cursor())
+
+	startAts = nil
+	it, err = client.Epics.IteratorFrom(5, &EpicsOptions{MaxResults: 2, Done: &done}, it.Cursor())
+	assert.Nil(t, err)
+	_, err = it.Next(context.Background())
+	assert.Equal(t, ErrNoMoreItems, err)
+	assert.Nil(t, startAts)
+}
+
+func TestEpicsServiceIteratorFromStale(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ids, startAts := []int{1, 2, 4, 5}, []int(nil)
+	mux.HandleFunc("/board/5/epic", epicsPageHandler(&ids, &startAts))
+
+	it, err := client.Epics.IteratorFrom(5, nil, PageCursor{Scope: "board/5/epic?done=", StartAt: 3, LastID: "3"})
+	assert.Nil(t, err)
+	_, err = it.Next(context.Background())
+	assert.Equal(t, ErrStaleCursor, err)
+	_, err = it.Next(context.Background())
+	assert.Equal(t, ErrStaleCursor, err)
+
+	it, err = client.Epics.IteratorFrom(5, nil, PageCursor{Scope: "board/5/epic?done=", StartAt: 6, LastID: "6"})
+	assert.Nil(t, err)
+	_, err = it.Next(context.Background())
+	assert.Equal(t, ErrStaleCursor, err)
+}
+
+func TestEpicsServiceIteratorFromInvalid(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	done := true
+	for _, cursor := range []PageCursor{
+		{Scope: "board/6/epic?done=", StartAt: 2, LastID: "2"},
+		{Scope: "board/5/epic?done=true", StartAt: 2, LastID: "2"},
+		{Scope: "board/5/epic?done=", StartAt: -1},
+		{Scope: "board/5/epic?done=", StartAt: 2},
+		{Scope: "board/5/epic?done=", LastID: "2"},
+		{Scope: "board/5/epic?done=", StartAt: 2, LastID: "MCP-2"},
+		{Scope: "board/5/epic?done=", StartAt: 2, LastID: "2", NextPageToken: "abc"},
+	} {
+		_, err := client.Epics.IteratorFrom(5, nil, cursor)
+		assert.Equal(t, ErrInvalidCursor, err, "%+v", cursor)
+	}
+
+	_, err := client.Epics.IteratorFrom(5, &EpicsOptions{Done: &done}, PageCursor{Scope: "board/5/epic?done=true", StartAt: 2, LastID: "2"})
+	assert.Nil(t, err)
+}
+
+func TestEpicsServiceCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	client.Epics.SetEpicNameFieldID("customfield_10011")
+
+	mux.HandleFunc("/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string]map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"key": "MCP"}, body["fields"]["project"])
+		assert.Equal(t, map[string]interface{}{"name": "Epic"}, body["fields"]["issuetype"])
+		assert.Equal(t, "New epic", body["fields"]["summary"])
+		assert.Equal(t, "Epic 2", body["fields"]["customfield_10011"])
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "10000","key": "MCP-10","self": "https://jira.mycompany.com/rest/api/2/issue/10000"}`)
+	})
+
+	epic, _, err := client.Epics.Create(context.Background(), "MCP", &Epic{Name: "Epic 2", Summary: "New epic"})
+	assert.Nil(t, err)
+	assert.Equal(t, 10000, epic.ID)
+	assert.Equal(t, "MCP-10", epic.Key)
+	assert.Equal(t, "Epic 2", epic.Name)
+}
+
+func TestEpicsServiceCreateFindsEpicNameField(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, fieldsAsJSON)
+	})
+	mux.HandleFunc("/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]map[string]interface{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "Epic 2", body["fields"]["customfield_10011"])
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "10000","key": "MCP-10"}`)
+	})
+
+	epic, _, err := client.Epics.Create(context.Background(), "MCP", &Epic{Name: "Epic 2"})
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-10", epic.Key)
+	assert.Equal(t, "Epic 2", epic.Summary)
+}
+
+func TestEpicsServiceCreateNilEpic(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Epics.Create(context.Background(), "MCP", nil)
+	assert.Equal(t, ErrNilEpic, err)
+}
+
+func TestEpicsServiceMoveIssuesToValidation(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	tooMany := &IssueKeys{}
+	for i := 0; i <= MaxIssuesPerMove; i++ {
+		tooMany.Issues = append(tooMany.Issues, fmt.Sprintf("MCP-%d", i))
+	}
+
+	tests := []struct {
+		Name string
+		Keys *IssueKeys
+		Err  error
+	}{
+		{Name: "nil keys", Keys: nil, Err: ErrNoIssues},
+		{Name: "empty keys", Keys: &IssueKeys{}, Err: ErrNoIssues},
+		{Name: "too many keys", Keys: tooMany, Err: ErrTooManyIssues},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			ok, resp, err := client.Epics.MoveIssuesTo(context.Background(), "5", tt.Keys)
+			assert.False(t, ok)
+			assert.Nil(t, resp)
+			assert.Equal(t, tt.Err, err)
+
+			_, _, err = client.Epics.RemoveIssuesFrom(context.Background(), tt.Keys)
+			assert.Equal(t, tt.Err, err)
+		})
+	}
+}
+
+func TestEpicsServiceMoveIssuesToBatched(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var sizes []int
+	mux.HandleFunc("/epic/5/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		keys := &IssueKeys{}
+		json.NewDecoder(r.Body).Decode(keys)
+		sizes = append(sizes, len(keys.Issues))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var keys []string
+	for i := 0; i < 120; i++ {
+		keys = append(keys, fmt.Sprintf("MCP-%d", i))
+	}
+
+	moved, resp, err := client.Epics.MoveIssuesToBatched(context.Background(), "5", keys)
+	assert.Nil(t, err)
+	assert.Equal(t, 120, moved)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, []int{50, 50, 20}, sizes)
+}
+
+func TestEpicsServiceMoveIssuesToBatchedFailure(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/epic/5/issue", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 2 {
+			http.Error(w, "Bad Request", http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	var keys []string
+	for i := 0; i < 120; i++ {
+		keys = append(keys, fmt.Sprintf("MCP-%d", i))
+	}
+
+	moved, resp, err := client.Epics.MoveIssuesToBatched(context.Background(), "5", keys)
+	assert.NotNil(t, err)
+	assert.Equal(t, 50, moved)
+	assert.Equal(t, 2, calls)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestEpicSetColor(t *testing.T) {
+	epic := &Epic{}
+
+	assert.Nil(t, epic.SetColor(EpicColor3))
+	assert.Equal(t, map[string]string{"key": "color_3"}, epic.Color)
+
+	assert.Equal(t, ErrInvalidEpicColor, epic.SetColor("color_10"))
+	assert.Equal(t, map[string]string{"key": "color_3"}, epic.Color)
+}
+
+func TestEpicsServiceEpicNameFieldID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, fieldsAsJSON)
+	})
+
+	id, err := client.Epics.EpicNameFieldID(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "customfield_10011", id)
+
+	client.Epics.SetEpicNameFieldID("customfield_10004")
+	id, err = client.Epics.EpicNameFieldID(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "customfield_10004", id)
+}
+
+func TestEpicsServiceRankBatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var ranked []string
+	for _, key := range []string{"MCP-2", "MCP-3"} {
+		key := key
+		mux.HandleFunc("/epic/"+key+"/rank", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "PUT", r.Method)
+			rank := &EpicRank{}
+			json.NewDecoder(r.Body).Decode(rank)
+			assert.Equal(t, "customfield_10019", rank.RankCustomFieldID)
+			ranked = append(ranked, rank.RankAfter+"<"+key)
+			w.WriteHeader(http.StatusNoContent)
+		})
+	}
+
+	resp, err := client.Epics.RankBatch(context.Background(), []string{"MCP-1", "MCP-2", "MCP-3"}, "customfield_10019")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, []string{"MCP-1<MCP-2", "MCP-2<MCP-3"}, ranked)
+}
+
+func TestEpicsServiceRankBatchError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-2/rank", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/epic/MCP-3/rank", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+	mux.HandleFunc("/epic/MCP-4/rank", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("epic ranked after a failure")
+	})
+
+	_, err := client.Epics.RankBatch(context.Background(), []string{"MCP-1", "MCP-2", "MCP-3", "MCP-4"}, "")
+	rankErr, ok := err.(*RankError)
+	assert.True(t, ok)
+	assert.Equal(t, "MCP-3", rankErr.Key)
+	_, ok = rankErr.Err.(*JiraError)
+	assert.True(t, ok)
+}
+
+func TestEpicsServiceRankBatchCanceled(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.Epics.RankBatch(ctx, []string{"MCP-1", "MCP-2"}, "")
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestEpicsServiceListIssuesAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-5/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "project=MCP", r.URL.Query().Get("jql"))
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"maxResults": 2,"startAt": 0,"issues": [{"key": "MCP-1"},{"key": "MCP-2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"maxResults": 2,"startAt": 2,"isLast": true,"issues": [{"key": "MCP-3"}]}`)
+		default:
+			t.Errorf("unexpected startAt %s", r.URL.Query().Get("startAt"))
+		}
+	})
+
+	issues, resp, err := client.Epics.ListIssuesAll(context.Background(), "MCP-5", &IssuesOptions{JQL: "project=MCP"})
+	assert.Nil(t, err)
+	assert.Len(t, issues, 3)
+	assert.Equal(t, "MCP-3", issues[2].Key)
+	assert.Equal(t, 2, resp.StartAt)
+}
+
+func TestEpicsServiceListIssuesWithoutEpicAllError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/none/issue", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("startAt") == "2" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `{"maxResults": 2,"startAt": 0,"issues": [{"key": "MCP-1"},{"key": "MCP-2"}]}`)
+	})
+
+	issues, resp, err := client.Epics.ListIssuesWithoutEpicAll(context.Background(), nil)
+	assert.NotNil(t, err)
+	assert.Len(t, issues, 2)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+}
+
+func TestEpicsServiceListIssuesAllCursor(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	keys := []string{"MCP-1", "MCP-2", "MCP-3", "MCP-4", "MCP-5"}
+	fail := true
+	var startAts []string
+	mux.HandleFunc("/epic/MCP-10/issue", func(w http.ResponseWriter, r *http.Request) {
+		startAts = append(startAts, r.URL.Query().Get("startAt"))
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		if startAt == 2 && fail {
+			fail = false
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		end := startAt + 2
+		if end > len(keys) {
+			end = len(keys)
+		}
+		var issues []string
+		for _, key := range keys[startAt:end] {
+			issues = append(issues, fmt.Sprintf(`{"key": "%s"}`, key))
+		}
+		fmt.Fprintf(w, `{"startAt": %d,"maxResults": 2,"total": %d,"isLast": %t,"issues": [%s]}`, startAt, len(keys), end == len(keys), strings.Join(issues, ","))
+	})
+
+	cursor := &PageCursor{}
+	opts := &IssuesOptions{MaxResults: 2, JQL: "status = Done", Cursor: cursor}
+	issues, _, err := client.Epics.ListIssuesAll(context.Background(), "MCP-10", opts)
+	assert.NotNil(t, err)
+	assert.Len(t, issues, 2)
+	assert.Equal(t, PageCursor{Scope: "epic/MCP-10/issue?jql=status+%3D+Done&orderBy=", StartAt: 2, LastID: "MCP-2"}, *cursor)
+
+	issues, _, err = client.Epics.ListIssuesAll(context.Background(), "MCP-10", opts)
+	assert.Nil(t, err)
+	var got []string
+	for _, issue := range issues {
+		got = append(got, issue.Key)
+	}
+	assert.Equal(t, []string{"MCP-3", "MCP-4", "MCP-5"}, got)
+	assert.Equal(t, []string{"", "2", "1", "3"}, startAts)
+	assert.True(t, cursor.Done)
+
+	issues, resp, err := client.Epics.ListIssuesAll(context.Background(), "MCP-10", opts)
+	assert.Nil(t, err)
+	assert.Nil(t, resp)
+	assert.Len(t, issues, 0)
+
+	stale := &PageCursor{Scope: cursor.Scope, StartAt: 2, LastID: "MCP-9"}
+	_, _, err = client.Epics.ListIssuesAll(context.Background(), "MCP-10", &IssuesOptions{MaxResults: 2, JQL: "status = Done", Cursor: stale})
+	assert.Equal(t, ErrStaleCursor, err)
+
+	for _, o := range []*IssuesOptions{
+		{JQL: "status = Open", Cursor: &PageCursor{Scope: cursor.Scope, StartAt: 2, LastID: "MCP-2"}},
+		{JQL: "status = Done", OrderBy: "created", Cursor: &PageCursor{Scope: cursor.Scope, StartAt: 2, LastID: "MCP-2"}},
+	} {
+		_, _, err = client.Epics.ListIssuesAll(context.Background(), "MCP-10", o)
+		assert.Equal(t, ErrInvalidCursor, err)
+	}
+	_, _, err = client.Epics.ListIssuesWithoutEpicAll(context.Background(), &IssuesOptions{JQL: "status = Done", Cursor: &PageCursor{Scope: cursor.Scope, StartAt: 2, LastID: "MCP-2"}})
+	assert.Equal(t, ErrInvalidCursor, err)
+}
+
+func TestEpicsServiceListIssuesAllDedup(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-5/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.URL.Query().Get("dedup"))
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"maxResults": 2,"startAt": 0,"issues": [{"key": "MCP-1"},{"key": "MCP-2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"maxResults": 2,"startAt": 2,"isLast": true,"issues": [{"key": "MCP-2"},{"key": "MCP-3"}]}`)
+		}
+	})
+
+	issues, resp, err := client.Epics.ListIssuesAll(context.Background(), "MCP-5", &IssuesOptions{Dedup: true})
+	assert.Nil(t, err)
+	assert.Len(t, issues, 3)
+	assert.Equal(t, 1, resp.Duplicates)
+
+	issues, resp, err = client.Epics.ListIssuesAll(context.Background(), "MCP-5", nil)
+	assert.Nil(t, err)
+	assert.Len(t, issues, 4)
+	assert.Equal(t, 0, resp.Duplicates)
+}
+
+func TestEpicsServiceListIssuesAllProgress(t *testing.T) {
+	for _, concurrency := range []int{0, 3} {
+		client, mux, _, teardown := setup()
+
+		mux.HandleFunc("/epic/MCP-1/issue", func(w http.ResponseWriter, r *http.Request) {
+			startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+			time.Sleep(time.Duration(10-startAt) * time.Millisecond)
+
+			var keys []string
+			for i := startAt; i < startAt+2 && i < 7; i++ {
+				keys = append(keys, fmt.Sprintf(`{"key": "MCP-%d"}`, i+2))
+			}
+			fmt.Fprintf(w, `{"maxResults": 2,"startAt": %d,"total": 7,"isLast": %t,"issues": [%s]}`, startAt, startAt+2 >= 7, strings.Join(keys, ","))
+		})
+
+		WithPageConcurrency(concurrency)(client)
+
+		var fetched, totals []int
+		opts := &IssuesOptions{Progress: func(n, total int) {
+			fetched = append(fetched, n)
+			totals = append(totals, total)
+		}}
+		issues, _, err := client.Epics.ListIssuesAll(context.Background(), "MCP-1", opts)
+		assert.Nil(t, err)
+		assert.Len(t, issues, 7)
+		assert.Equal(t, []int{2, 4, 6, 7}, fetched, "concurrency %d", concurrency)
+		assert.Equal(t, []int{7, 7, 7, 7}, totals)
+
+		teardown()
+	}
+}
+
+func TestEpicsServiceListIssuesAllConcurrent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	var inflight, maxInflight int
+	mux.HandleFunc("/epic/MCP-1/issue", func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		inflight++
+		if inflight > maxInflight {
+			maxInflight = inflight
+		}
+		mu.Unlock()
+		defer func() {
+			mu.Lock()
+			inflight--
+			mu.Unlock()
+		}()
+
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		if startAt > 0 {
+			assert.Equal(t, "2", r.URL.Query().Get("maxResults"))
+			time.Sleep(time.Duration(10-startAt) * time.Millisecond)
+		}
+
+		var keys []string
+		for i := startAt; i < startAt+2 && i < 7; i++ {
+			keys = append(keys, fmt.Sprintf(`{"key": "MCP-%d"}`, i+2))
+		}
+		fmt.Fprintf(w, `{"maxResults": 2,"startAt": %d,"total": 7,"isLast": %t,"issues": [%s]}`, startAt, startAt+2 >= 7, strings.Join(keys, ","))
+	})
+
+	WithPageConcurrency(2)(client)
+
+	issues, resp, err := client.Epics.ListIssuesAll(context.Background(), "MCP-1", nil)
+	assert.Nil(t, err)
+	var keys []string
+	for _, issue := range issues {
+		keys = append(keys, issue.Key)
+	}
+	assert.Equal(t, []string{"MCP-2", "MCP-3", "MCP-4", "MCP-5", "MCP-6", "MCP-7", "MCP-8"}, keys)
+	assert.Equal(t, 6, resp.StartAt)
+	assert.True(t, resp.IsLast)
+	assert.LessOrEqual(t, maxInflight, 2)
+}
+
+func TestEpicsServiceListIssuesAllConcurrentError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-1/issue", func(w http.ResponseWriter, r *http.Request) {
+		startAt, _ := strconv.Atoi(r.URL.Query().Get("startAt"))
+		if startAt == 4 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprintf(w, `{"maxResults": 2,"startAt": %d,"total": 8,"issues": [{"key": "MCP-%d"},{"key": "MCP-%d"}]}`, startAt, startAt+1, startAt+2)
+	})
+
+	WithPageConcurrency(4)(client)
+
+	issues, resp, err := client.Epics.ListIssuesAll(context.Background(), "MCP-1", nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusInternalServerError, resp.StatusCode)
+	assert.True(t, len(issues) == 2 || len(issues) == 4)
+	assert.Equal(t, "MCP-1", issues[0].Key)
+}
+
+func TestEpicsServiceListIssuesAllConcurrentWithoutTotal(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls []string
+	mux.HandleFunc("/epic/MCP-1/issue", func(w http.ResponseWriter, r *http.Request) {
+		calls = append(calls, r.URL.Query().Get("startAt"))
+		if r.URL.Query().Get("startAt") == "" {
+			fmt.Fprint(w, `{"maxResults": 2,"startAt": 0,"issues": [{"key": "MCP-1"},{"key": "MCP-2"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"maxResults": 2,"startAt": 2,"isLast": true,"issues": [{"key": "MCP-3"}]}`)
+	})
+
+	WithPageConcurrency(4)(client)
+
+	issues, _, err := client.Epics.ListIssuesAll(context.Background(), "MCP-1", nil)
+	assert.Nil(t, err)
+	assert.Len(t, issues, 3)
+	assert.Equal(t, []string{"", "2"}, calls)
+}
+
+func TestEpicsServiceMoveIssuesToVerified(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-1/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/issue/MCP-2", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "epic", r.URL.Query().Get("fields"))
+		fmt.Fprint(w, `{"key": "MCP-2","fields": {"epic": {"id": 10001,"key": "MCP-1"}}}`)
+	})
+	mux.HandleFunc("/issue/MCP-3", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key": "MCP-3","fields": {"epic": {"id": 10009,"key": "MCP-9"}}}`)
+	})
+	mux.HandleFunc("/issue/MCP-4", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	result, resp, err := client.Epics.MoveIssuesToVerified(context.Background(), "MCP-1", []string{"MCP-2", "MCP-3", "MCP-4"})
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, []string{"MCP-2"}, result.Moved)
+	assert.Equal(t, []string{"MCP-3", "MCP-4"}, result.NotMoved)
+}
+
+func TestEpicsServiceMoveIssuesToVerifiedNone(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/none/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/issue/MCP-2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key": "MCP-2","fields": {}}`)
+	})
+	mux.HandleFunc("/issue/MCP-3", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key": "MCP-3","fields": {"epic": {"id": 10001,"key": "MCP-1"}}}`)
+	})
+
+	result, _, err := client.Epics.MoveIssuesToVerified(context.Background(), "none", []string{"MCP-2", "MCP-3"})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"MCP-2"}, result.Moved)
+	assert.Equal(t, []string{"MCP-3"}, result.NotMoved)
+}
+
+func TestEpicsServiceMoveIssuesToVerifiedMoveError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-1/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	result, resp, err := client.Epics.MoveIssuesToVerified(context.Background(), "MCP-1", []string{"MCP-2"})
+	assert.NotNil(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestEpicsServiceGetByName(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/1/epic", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("startAt") == "" {
+			fmt.Fprint(w, `{"maxResults": 2,"startAt": 0,"values": [{"id": 1,"key": "MCP-1","name": "Checkout"},{"id": 2,"key": "MCP-2","name": "Payments"}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"maxResults": 2,"startAt": 2,"isLast": true,"values": [{"id": 3,"key": "MCP-3","name": "payments"},{"id": 4,"key": "MCP-4","name": "Search"}]}`)
+	})
+
+	epic, _, err := client.Epics.GetByName(context.Background(), 1, "Search")
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-4", epic.Key)
+
+	epic, _, err = client.Epics.GetByName(context.Background(), 1, "payments")
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-3", epic.Key)
+
+	_, _, err = client.Epics.GetByName(context.Background(), 1, "checkout")
+	assert.Equal(t, ErrEpicNotFound, err)
+
+	epic, _, err = client.Epics.GetByNameFold(context.Background(), 1, "checkout")
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-1", epic.Key)
+
+	_, _, err = client.Epics.GetByNameFold(context.Background(), 1, "PAYMENTS")
+	assert.Equal(t, ErrMultipleEpics, err)
+}
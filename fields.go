@@ -0,0 +1,178 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrFieldNotFound is returned by FieldsService.FindByName when no field has the given name
+var ErrFieldNotFound = errors.New("jira: field not found")
+
+// FieldsService handles communication with the field related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/field
+type FieldsService service
+
+// Field represents a system or custom field of Jira Issue
+type Field struct {
+	ID          string       `json:"id,omitempty"`
+	Key         string       `json:"key,omitempty"`
+	Name        string       `json:"name,omitempty"`
+	Custom      bool         `json:"custom,omitempty"`
+	Orderable   bool         `json:"orderable,omitempty"`
+	Navigable   bool         `json:"navigable,omitempty"`
+	Searchable  bool         `json:"searchable,omitempty"`
+	ClauseNames []string     `json:"clauseNames,omitempty"`
+	Schema      *FieldSchema `json:"schema,omitempty"`
+}
+
+// FieldSchema represents the data type of a field
+type FieldSchema struct {
+	Type     string `json:"type,omitempty"`
+	Items    string `json:"items,omitempty"`
+	System   string `json:"system,omitempty"`
+	Custom   string `json:"custom,omitempty"`
+	CustomID int    `json:"customId,omitempty"`
+}
+
+// List returns all system and custom fields.
+//
+// GET /rest/api/2/field
+func (f *FieldsService) List(ctx context.Context) ([]*Field, *Response, error) {
+
+	req, err := f.client.NewRequest("GET", f.client.apiPath("field"), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fields []*Field
+	resp, err := f.client.Do(ctx, req, &fields)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return fields, resp, nil
+}
+
+// FindByName returns the field whose name matches the given one, ignoring case, e.g.
+// "Epic Name" or "Rank", so the ids of custom fields do not need to be known beforehand.
+// The fields are listed on the first call and cached by the client for the next ones,
+// until the cache expires (see WithFieldCache) or is invalidated by InvalidateCache.
+// ErrFieldNotFound is returned when there is no such field.
+func (f *FieldsService) FindByName(ctx context.Context, name string) (*Field, error) {
+	fields, err := f.cached(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, name) {
+			return field, nil
+		}
+	}
+
+	return nil, ErrFieldNotFound
+}
+
+// InvalidateCache discards the cached fields, so the next FindByName lists them again,
+// e.g. after a custom field is added.
+func (f *FieldsService) InvalidateCache() {
+	f.client.fields.invalidate()
+}
+
+// cached returns the cached fields, listing them when the cache is empty or expired
+func (f *FieldsService) cached(ctx context.Context) ([]*Field, error) {
+	v, err := f.client.fields.get(ctx, func(ctx context.Context) (interface{}, error) {
+		fields, _, err := f.List(ctx)
+		return fields, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.([]*Field), nil
+}
+
+// listCache holds the result of a list request, e.g. the fields listed by FieldsService.
+// Concurrent callers that miss the cache wait for a single request to the API instead of
+// sending one each.
+type listCache struct {
+	ttl time.Duration
+
+	mu        sync.Mutex
+	value     interface{}
+	fetchedAt time.Time
+	inflight  *listFetch
+	// generation is incremented by invalidate, so that the requests sent before are not cached
+	generation int
+}
+
+// listFetch is a list request, shared by the callers waiting for it
+type listFetch struct {
+	done       chan struct{}
+	generation int
+	value      interface{}
+	err        error
+}
+
+// get returns the cached value, calling list when the cache is empty or expired. A caller
+// waiting for the request of another one sends its own when that request failed because
+// the context of the other caller is done, its own context not being done.
+func (c *listCache) get(ctx context.Context, list func(context.Context) (interface{}, error)) (interface{}, error) {
+	for {
+		c.mu.Lock()
+		if c.value != nil && (c.ttl <= 0 || time.Since(c.fetchedAt) < c.ttl) {
+			value := c.value
+			c.mu.Unlock()
+			return value, nil
+		}
+
+		fetch := c.inflight
+		if fetch == nil {
+			break
+		}
+		c.mu.Unlock()
+
+		select {
+		case <-fetch.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if (fetch.err == context.Canceled || fetch.err == context.DeadlineExceeded) && ctx.Err() == nil {
+			continue
+		}
+		return fetch.value, fetch.err
+	}
+
+	fetch := &listFetch{done: make(chan struct{}), generation: c.generation}
+	c.inflight = fetch
+	c.mu.Unlock()
+
+	fetch.value, fetch.err = list(ctx)
+
+	c.mu.Lock()
+	if fetch.err == nil && fetch.generation == c.generation {
+		c.value = fetch.value
+		c.fetchedAt = time.Now()
+	}
+	if c.inflight == fetch {
+		c.inflight = nil
+	}
+	c.mu.Unlock()
+	close(fetch.done)
+
+	return fetch.value, fetch.err
+}
+
+// invalidate discards the cached value, and the value of the request in flight, if any, the
+// next callers sending a new one
+func (c *listCache) invalidate() {
+	c.mu.Lock()
+	c.value = nil
+	c.inflight = nil
+	c.generation++
+	c.mu.Unlock()
+}
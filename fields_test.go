@@ -0,0 +1,216 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var fieldsAsJSON = `[
+	{"id": "summary","key": "summary","name": "Summary","custom": false,"schema": {"type": "string","system": "summary"}},
+	{"id": "customfield_10011","key": "customfield_10011","name": "Epic Name","custom": true,"schema": {"type": "string","custom": "com.pyxis.greenhopper.jira:gh-epic-label","customId": 10011}}
+]`
+
+func TestFieldsServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, fieldsAsJSON)
+	})
+
+	fields, _, err := client.Fields.List(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, fields, 2)
+	assert.False(t, fields[0].Custom)
+	assert.Equal(t, "customfield_10011", fields[1].ID)
+	assert.True(t, fields[1].Custom)
+	assert.Equal(t, 10011, fields[1].Schema.CustomID)
+}
+
+func TestFieldsServiceFindByName(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, fieldsAsJSON)
+	})
+
+	field, err := client.Fields.FindByName(context.Background(), "epic name")
+	assert.Nil(t, err)
+	assert.Equal(t, "customfield_10011", field.ID)
+
+	field, err = client.Fields.FindByName(context.Background(), "SUMMARY")
+	assert.Nil(t, err)
+	assert.Equal(t, "summary", field.ID)
+
+	_, err = client.Fields.FindByName(context.Background(), "Story Points")
+	assert.Equal(t, ErrFieldNotFound, err)
+
+	assert.Equal(t, 1, calls)
+}
+
+func TestFieldsServiceFindByNameCacheExpires(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, fieldsAsJSON)
+	})
+
+	WithFieldCache(10 * time.Millisecond)(client)
+
+	_, err := client.Fields.FindByName(context.Background(), "Summary")
+	assert.Nil(t, err)
+	_, err = client.Fields.FindByName(context.Background(), "Summary")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, calls)
+
+	time.Sleep(20 * time.Millisecond)
+	_, err = client.Fields.FindByName(context.Background(), "Summary")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+func TestFieldsServiceInvalidateCache(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, fieldsAsJSON)
+	})
+
+	client.Fields.FindByName(context.Background(), "Summary")
+	client.Fields.InvalidateCache()
+	client.Fields.FindByName(context.Background(), "Summary")
+
+	assert.Equal(t, 2, calls)
+}
+
+func TestFieldsServiceFindByNameConcurrent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var calls int32
+	mux.HandleFunc("/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		time.Sleep(20 * time.Millisecond)
+		fmt.Fprint(w, fieldsAsJSON)
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			field, err := client.Fields.FindByName(context.Background(), "Epic Name")
+			assert.Nil(t, err)
+			assert.Equal(t, "customfield_10011", field.ID)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestFieldsServiceFindByNameError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/api/2/field", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, fieldsAsJSON)
+	})
+
+	_, err := client.Fields.FindByName(context.Background(), "Summary")
+	assert.NotNil(t, err)
+
+	field, err := client.Fields.FindByName(context.Background(), "Summary")
+	assert.Nil(t, err)
+	assert.Equal(t, "summary", field.ID)
+}
+
+func TestListCacheWaiterRetriesCanceledFetch(t *testing.T) {
+	c := &listCache{}
+
+	started := make(chan struct{})
+	var calls int32
+	list := func(ctx context.Context) (interface{}, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(started)
+			<-ctx.Done()
+			return nil, ctx.Err()
+		}
+		return "fields", nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	leader := make(chan error)
+	go func() {
+		_, err := c.get(ctx, list)
+		leader <- err
+	}()
+	<-started
+
+	waiter := make(chan interface{})
+	go func() {
+		v, err := c.get(context.Background(), list)
+		assert.Nil(t, err)
+		waiter <- v
+	}()
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	assert.Equal(t, context.Canceled, <-leader)
+	assert.Equal(t, "fields", <-waiter)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
+
+func TestListCacheInvalidateDuringFetch(t *testing.T) {
+	c := &listCache{}
+
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var calls int32
+	list := func(ctx context.Context) (interface{}, error) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(started)
+			<-release
+			return "stale", nil
+		}
+		return "fresh", nil
+	}
+
+	done := make(chan interface{})
+	go func() {
+		v, _ := c.get(context.Background(), list)
+		done <- v
+	}()
+	<-started
+	c.invalidate()
+	close(release)
+	assert.Equal(t, "stale", <-done)
+
+	v, err := c.get(context.Background(), list)
+	assert.Nil(t, err)
+	assert.Equal(t, "fresh", v)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&calls))
+}
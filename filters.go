@@ -0,0 +1,207 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// FiltersService handles communication with the filter related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/filter
+type FiltersService service
+
+// Filter represents a saved JQL filter
+type Filter struct {
+	ID               string             `json:"id,omitempty"`
+	Name             string             `json:"name,omitempty"`
+	SelfLink         string             `json:"self,omitempty"`
+	Description      string             `json:"description,omitempty"`
+	JQL              string             `json:"jql,omitempty"`
+	Owner            *IssueUser         `json:"owner,omitempty"`
+	Favourite        bool               `json:"favourite,omitempty"`
+	ViewURL          string             `json:"viewUrl,omitempty"`
+	SearchURL        string             `json:"searchUrl,omitempty"`
+	SharePermissions []*SharePermission `json:"sharePermissions,omitempty"`
+}
+
+// SharePermission represents who a filter or a dashboard is shared with. Type is one of
+// user, group, project, projectRole, global, loggedin or authenticated, and the matching
+// field, if any, describes the user, group, project or role.
+type SharePermission struct {
+	ID      int        `json:"id,omitempty"`
+	Type    string     `json:"type,omitempty"`
+	User    *IssueUser `json:"user,omitempty"`
+	Group   *Group     `json:"group,omitempty"`
+	Project *Project   `json:"project,omitempty"`
+	Role    *Role      `json:"role,omitempty"`
+}
+
+// Group represents a group of users
+type Group struct {
+	Name     string `json:"name,omitempty"`
+	GroupID  string `json:"groupId,omitempty"`
+	SelfLink string `json:"self,omitempty"`
+}
+
+// Role represents a project role
+type Role struct {
+	ID          int    `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	SelfLink    string `json:"self,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// FilterWrap represents the data returned by the filter search,
+// in addition to the filter information, paging data is returned
+type FilterWrap struct {
+	Pagination
+	Values []*Filter `json:"values,omitempty"`
+}
+
+// FilterOptions contains all options to get a filter
+type FilterOptions struct {
+	//Expands additional information in the response, e.g. sharedUsers, subscriptions.
+	Expand []string `query:"expand"`
+}
+
+// FilterSearchOptions contains all options to search for filters
+type FilterSearchOptions struct {
+	//The index of the first filter to return (0-based)
+	StartAt int `query:"startAt"`
+	//The maximum number of filters to return per page. Default: 50.
+	MaxResults int `query:"maxResults"`
+	//Filters the results by a case-insensitive partial match of the filter name.
+	FilterName string `query:"filterName"`
+	//Filters the results by the owner of the filter.
+	AccountID string `query:"accountId"`
+	//Filters the results by the group the filter is shared with.
+	GroupName string `query:"groupname"`
+	//Filters the results by the project the filter is shared with.
+	ProjectID int `query:"projectId"`
+	//Orders the results by a field, e.g. id, name, owner, favourite_count. Prefix with - to sort in descending order.
+	OrderBy string `query:"orderBy"`
+	//Expands additional information in the response, e.g. description, jql, owner, sharePermissions.
+	Expand []string `query:"expand"`
+}
+
+// Get returns a filter, for a given filter Id.
+//
+// GET /rest/api/2/filter/{id}
+func (f *FiltersService) Get(ctx context.Context, id string, opts *FilterOptions) (*Filter, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := f.client.NewRequest("GET", f.client.apiPath(fmt.Sprintf("filter/%s%s", id, q)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var filter = &Filter{}
+	resp, err := f.client.Do(ctx, req, filter)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return filter, resp, nil
+}
+
+// Search returns the filters visible to the user, a page at a time.
+// Only the id, name and self link of the filters are returned unless opts.Expand is set.
+//
+// GET /rest/api/2/filter/search
+func (f *FiltersService) Search(ctx context.Context, opts *FilterSearchOptions) ([]*Filter, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := f.client.NewRequest("GET", f.client.apiPath(fmt.Sprintf("filter/search%s", q)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &FilterWrap{}
+	resp, err := f.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.MaxResults = wrap.MaxResults
+	resp.StartAt = wrap.StartAt
+	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
+
+	return wrap.Values, resp, nil
+}
+
+// Favourites returns the filters marked as favourite by the user.
+//
+// GET /rest/api/2/filter/favourite
+func (f *FiltersService) Favourites(ctx context.Context) ([]*Filter, *Response, error) {
+
+	req, err := f.client.NewRequest("GET", f.client.apiPath("filter/favourite"), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var filters []*Filter
+	resp, err := f.client.Do(ctx, req, &filters)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return filters, resp, nil
+}
+
+// Create creates a filter owned by the user. Name and JQL are required.
+// It returns the created filter.
+//
+// POST /rest/api/2/filter
+func (f *FiltersService) Create(ctx context.Context, filter *Filter) (*Filter, *Response, error) {
+
+	req, err := f.client.NewRequest("POST", f.client.apiPath("filter"), filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created = &Filter{}
+	resp, err := f.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// Update updates a filter, for a given filter Id. Only the owner of the filter can update it.
+// It returns the updated filter.
+//
+// PUT /rest/api/2/filter/{id}
+func (f *FiltersService) Update(ctx context.Context, id string, filter *Filter) (*Filter, *Response, error) {
+
+	req, err := f.client.NewRequest("PUT", f.client.apiPath(fmt.Sprintf("filter/%s", id)), filter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated = &Filter{}
+	resp, err := f.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, nil
+}
+
+// Delete deletes a filter, for a given filter Id.
+//
+// DELETE /rest/api/2/filter/{id}
+func (f *FiltersService) Delete(ctx context.Context, id string) (*Response, error) {
+
+	req, err := f.client.NewRequest("DELETE", f.client.apiPath(fmt.Sprintf("filter/%s", id)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return f.client.Do(ctx, req, nil)
+}
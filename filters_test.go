@@ -0,0 +1,127 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var filterAsJSON = `{
+	"id": "10000",
+	"name": "My open issues",
+	"jql": "assignee = currentUser() AND resolution = Unresolved",
+	"owner": {"accountId": "5b10a2844c20165700ede21g"},
+	"favourite": true,
+	"sharePermissions": [{"id": 10057,"type": "group","group": {"name": "jira-developers","groupId": "276f955c-63d7-42c8-9520-92d01dca0625"}}]
+}`
+
+func TestFiltersServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/filter/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "sharedUsers", r.URL.Query().Get("expand"))
+		fmt.Fprint(w, filterAsJSON)
+	})
+
+	filter, _, err := client.Filters.Get(context.Background(), "10000", &FilterOptions{Expand: []string{"sharedUsers"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "My open issues", filter.Name)
+	assert.Equal(t, "assignee = currentUser() AND resolution = Unresolved", filter.JQL)
+	assert.Equal(t, "5b10a2844c20165700ede21g", filter.Owner.AccountID)
+	assert.True(t, filter.Favourite)
+	assert.Equal(t, "group", filter.SharePermissions[0].Type)
+	assert.Equal(t, "jira-developers", filter.SharePermissions[0].Group.Name)
+}
+
+func TestFiltersServiceSearch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/filter/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "open", r.URL.Query().Get("filterName"))
+		assert.Equal(t, "1", r.URL.Query().Get("startAt"))
+		fmt.Fprint(w, `{"maxResults": 1,"startAt": 1,"total": 3,"isLast": false,"values": [{"id": "10001","name": "Open bugs"}]}`)
+	})
+
+	filters, resp, err := client.Filters.Search(context.Background(), &FilterSearchOptions{FilterName: "open", StartAt: 1})
+	assert.Nil(t, err)
+	assert.Len(t, filters, 1)
+	assert.Equal(t, "Open bugs", filters[0].Name)
+	assert.Equal(t, 1, resp.StartAt)
+	assert.True(t, resp.HasMore())
+	assert.Equal(t, 2, resp.NextStartAt())
+}
+
+func TestFiltersServiceFavourites(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/filter/favourite", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprintf(w, `[%s]`, filterAsJSON)
+	})
+
+	filters, _, err := client.Filters.Favourites(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, filters, 1)
+	assert.Equal(t, "10000", filters[0].ID)
+}
+
+func TestFiltersServiceCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/filter", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"name": "My open issues", "jql": "assignee = currentUser()"}, body)
+
+		fmt.Fprint(w, filterAsJSON)
+	})
+
+	filter, _, err := client.Filters.Create(context.Background(), &Filter{Name: "My open issues", JQL: "assignee = currentUser()"})
+	assert.Nil(t, err)
+	assert.Equal(t, "10000", filter.ID)
+}
+
+func TestFiltersServiceUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/filter/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"description": "Unresolved issues assigned to me"}, body)
+
+		fmt.Fprint(w, filterAsJSON)
+	})
+
+	filter, _, err := client.Filters.Update(context.Background(), "10000", &Filter{Description: "Unresolved issues assigned to me"})
+	assert.Nil(t, err)
+	assert.Equal(t, "My open issues", filter.Name)
+}
+
+func TestFiltersServiceDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/filter/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Filters.Delete(context.Background(), "10000")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
@@ -5,4 +5,5 @@ go 1.12
 require (
 	github.com/fatih/structs v1.1.0
 	github.com/stretchr/testify v1.7.0
+	golang.org/x/oauth2 v0.0.0-20210210192628-66670185b0cd
 )
@@ -0,0 +1,143 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+)
+
+// GroupsService handles communication with the group related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/group
+type GroupsService service
+
+// GroupMemberWrap represents the data returned by the API,
+// in addition to the user information, paging data is returned
+type GroupMemberWrap struct {
+	Pagination
+	Values []*IssueUser `json:"values,omitempty"`
+}
+
+// GroupMembersOptions contains all options to list the members of a group
+type GroupMembersOptions struct {
+	//The index of the first user to return (0-based)
+	StartAt int `query:"startAt"`
+	//The maximum number of users to return per page. Default: 50.
+	MaxResults int `query:"maxResults"`
+	//Include inactive users.
+	IncludeInactiveUsers bool `query:"includeInactiveUsers"`
+}
+
+// GroupAccessError is returned by GroupsService when the API refuses to disclose or change the
+// members of a group, e.g. on Cloud when the user has no permission to browse users and groups
+// or the group is managed by the organization.
+type GroupAccessError struct {
+	// Group is the name or id of the group
+	Group string
+	Err   *ErrorResponse
+}
+
+func (e *GroupAccessError) Error() string {
+	return fmt.Sprintf("jira: access to group %s denied: %v", e.Group, e.Err)
+}
+
+// Unwrap returns the error returned by the API
+func (e *GroupAccessError) Unwrap() error {
+	return e.Err
+}
+
+// groupIDPattern matches the ids of groups, which replace their names on Cloud
+var groupIDPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// groupParameter returns the query parameter identifying a group, groupId when the
+// given group is an id, as on Cloud, groupname otherwise.
+func groupParameter(group string) string {
+	if groupIDPattern.MatchString(group) {
+		return "groupId=" + url.QueryEscape(group)
+	}
+	return "groupname=" + url.QueryEscape(group)
+}
+
+// groupError returns a *GroupAccessError when the API denied the access to a group
+func groupError(group string, err error) error {
+	if errResp, ok := err.(*ErrorResponse); ok && errResp.StatusCode == http.StatusForbidden {
+		return &GroupAccessError{Group: group, Err: errResp}
+	}
+	return err
+}
+
+// Get returns the members of a group, a page at a time, for a given group name, or group Id on Cloud.
+// If the members cannot be disclosed to the user, a *GroupAccessError is returned.
+//
+// GET /rest/api/2/group/member
+func (g *GroupsService) Get(ctx context.Context, group string, opts *GroupMembersOptions) ([]*IssueUser, *Response, error) {
+
+	q := QueryParameters(opts)
+	if q == "" {
+		q = "?"
+	} else {
+		q += "&"
+	}
+	q += groupParameter(group)
+
+	req, err := g.client.NewRequest("GET", g.client.apiPath(fmt.Sprintf("group/member%s", q)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &GroupMemberWrap{}
+	resp, err := g.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, groupError(group, err)
+	}
+
+	resp.MaxResults = wrap.MaxResults
+	resp.StartAt = wrap.StartAt
+	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
+
+	return wrap.Values, resp, nil
+}
+
+// AddUser adds a user to a group, for a given group name, or group Id on Cloud, and user account Id.
+// If the group cannot be changed by the user, a *GroupAccessError is returned.
+//
+// POST /rest/api/2/group/user
+func (g *GroupsService) AddUser(ctx context.Context, group string, accountID string) (*Response, error) {
+
+	body := map[string]string{"accountId": accountID}
+	req, err := g.client.NewRequest("POST", g.client.apiPath(fmt.Sprintf("group/user?%s", groupParameter(group))), body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := g.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, groupError(group, err)
+	}
+
+	return resp, nil
+}
+
+// RemoveUser removes a user from a group, for a given group name, or group Id on Cloud, and user account Id.
+// If the group cannot be changed by the user, a *GroupAccessError is returned.
+//
+// DELETE /rest/api/2/group/user
+func (g *GroupsService) RemoveUser(ctx context.Context, group string, accountID string) (*Response, error) {
+
+	req, err := g.client.NewRequest("DELETE", g.client.apiPath(fmt.Sprintf("group/user?%s&accountId=%s", groupParameter(group), url.QueryEscape(accountID))), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := g.client.Do(ctx, req, nil)
+	if err != nil {
+		return resp, groupError(group, err)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,109 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupsServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/group/member", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "jira developers", r.URL.Query().Get("groupname"))
+		assert.Equal(t, "true", r.URL.Query().Get("includeInactiveUsers"))
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 2,"total": 3,"isLast": false,"values": [{"accountId": "5b10a2844c20165700ede21g"},{"accountId": "5b10ac8d82e05b22cc7d4ef5"}]}`)
+	})
+
+	users, resp, err := client.Groups.Get(context.Background(), "jira developers", &GroupMembersOptions{IncludeInactiveUsers: true})
+	assert.Nil(t, err)
+	assert.Len(t, users, 2)
+	assert.Equal(t, "5b10a2844c20165700ede21g", users[0].AccountID)
+	assert.True(t, resp.HasMore())
+	assert.Equal(t, 2, resp.NextStartAt())
+}
+
+func TestGroupsServiceGetByGroupID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/group/member", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "276f955c-63d7-42c8-9520-92d01dca0625", r.URL.Query().Get("groupId"))
+		assert.Equal(t, "", r.URL.Query().Get("groupname"))
+		fmt.Fprint(w, `{"isLast": true,"values": []}`)
+	})
+
+	_, _, err := client.Groups.Get(context.Background(), "276f955c-63d7-42c8-9520-92d01dca0625", nil)
+	assert.Nil(t, err)
+}
+
+func TestGroupsServiceGetForbidden(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/group/member", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errorMessages": ["You do not have permission to view the members of this group."]}`)
+	})
+
+	_, _, err := client.Groups.Get(context.Background(), "site-admins", nil)
+	gerr, ok := err.(*GroupAccessError)
+	assert.True(t, ok)
+	assert.Equal(t, "site-admins", gerr.Group)
+	assert.Equal(t, http.StatusForbidden, gerr.Err.StatusCode)
+}
+
+func TestGroupsServiceAddUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "jira-developers", r.URL.Query().Get("groupname"))
+
+		body := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "5b10a2844c20165700ede21g", body["accountId"])
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name": "jira-developers"}`)
+	})
+
+	resp, err := client.Groups.AddUser(context.Background(), "jira-developers", "5b10a2844c20165700ede21g")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+}
+
+func TestGroupsServiceRemoveUser(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "jira-developers", r.URL.Query().Get("groupname"))
+		assert.Equal(t, "5b10a2844c20165700ede21g", r.URL.Query().Get("accountId"))
+		w.WriteHeader(http.StatusOK)
+	})
+
+	_, err := client.Groups.RemoveUser(context.Background(), "jira-developers", "5b10a2844c20165700ede21g")
+	assert.Nil(t, err)
+}
+
+func TestGroupsServiceRemoveUserForbidden(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/group/user", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	_, err := client.Groups.RemoveUser(context.Background(), "site-admins", "5b10a2844c20165700ede21g")
+	_, ok := err.(*GroupAccessError)
+	assert.True(t, ok)
+}
@@ -0,0 +1,179 @@
+package jira
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// impersonationAudience is the authorization server of the Atlassian Connect apps, which grants
+// the tokens to act on behalf of the users
+const impersonationAudience = "https://oauth-2-authorization-server.services.atlassian.com"
+
+// impersonationTokenURL is the endpoint granting the tokens to act on behalf of the users
+const impersonationTokenURL = impersonationAudience + "/oauth2/token"
+
+// impersonationAssertionTTL is the lifetime of the assertion sent to get a token
+const impersonationAssertionTTL = time.Minute
+
+// ErrImpersonationDenied is matched, e.g. by errors.Is, by the *ImpersonationError returned when
+// Jira refuses to run the requests on behalf of the user set by WithExecuteAs
+var ErrImpersonationDenied = errors.New("jira: impersonation denied")
+
+// ErrNoConnectApp is returned by NewClient when WithExecuteAs is used without WithConnectApp
+var ErrNoConnectApp = errors.New("jira: executing as another user requires the credentials of a Connect app, see WithConnectApp")
+
+// ImpersonationError is returned when the authorization server refuses the token to act on behalf
+// of the user set by WithExecuteAs, e.g. because the app does not have the ACT_AS_USER scope or
+// is not installed on the instance, or the user does not exist.
+type ImpersonationError struct {
+	// AccountID is the account Id of the impersonated user
+	AccountID string
+	Err       *ErrorResponse
+}
+
+func (e *ImpersonationError) Error() string {
+	return fmt.Sprintf("jira: impersonation of %s denied: %v", e.AccountID, e.Err)
+}
+
+// Unwrap returns the error returned by the authorization server
+func (e *ImpersonationError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrImpersonationDenied
+func (e *ImpersonationError) Is(target error) bool {
+	return target == ErrImpersonationDenied
+}
+
+// connectApp holds the credentials of the Atlassian Connect app set by WithConnectApp
+type connectApp struct {
+	oauthClientID string
+	sharedSecret  string
+	scopes        []string
+}
+
+// impersonationTokenSource gets the tokens of a Connect app to act on behalf of a user, with the
+// JWT bearer grant of the OAuth 2.0 authorization server of Atlassian
+type impersonationTokenSource struct {
+	ctx       context.Context
+	client    *http.Client
+	tokenURL  string
+	app       *connectApp
+	instance  string
+	accountID string
+}
+
+// Token implements the oauth2.TokenSource interface
+func (s *impersonationTokenSource) Token() (*oauth2.Token, error) {
+	assertion, err := s.assertion(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	if len(s.app.scopes) > 0 {
+		form.Set("scope", strings.Join(s.app.scopes, " "))
+	}
+
+	req, err := http.NewRequest("POST", s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := s.client.Do(req.WithContext(s.ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse(resp); err != nil {
+		if errResp, ok := err.(*ErrorResponse); ok && impersonationRefused(errResp.StatusCode) {
+			return nil, &ImpersonationError{AccountID: s.accountID, Err: errResp}
+		}
+		return nil, err
+	}
+
+	var grant struct {
+		AccessToken string `json:"access_token"`
+		TokenType   string `json:"token_type"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&grant); err != nil {
+		return nil, err
+	}
+	if grant.AccessToken == "" {
+		return nil, errors.New("jira: the authorization server returned no access token")
+	}
+
+	token := &oauth2.Token{AccessToken: grant.AccessToken, TokenType: grant.TokenType}
+	if grant.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(grant.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
+
+// assertion returns the JWT, signed with the shared secret of the app, asking for a token to act
+// on behalf of the user on the instance
+func (s *impersonationTokenSource) assertion(now time.Time) (string, error) {
+	header, err := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]interface{}{
+		"iss": "urn:atlassian:connect:clientid:" + s.app.oauthClientID,
+		"sub": "urn:atlassian:connect:useraccountid:" + s.accountID,
+		"tnt": s.instance,
+		"aud": impersonationAudience,
+		"iat": now.Unix(),
+		"exp": now.Add(impersonationAssertionTTL).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	enc := base64.RawURLEncoding
+	unsigned := enc.EncodeToString(header) + "." + enc.EncodeToString(claims)
+	mac := hmac.New(sha256.New, []byte(s.app.sharedSecret))
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + enc.EncodeToString(mac.Sum(nil)), nil
+}
+
+// impersonationRefused reports whether the status code of the authorization server refuses the
+// grant itself, rather than failing, e.g. 503 Service Unavailable
+func impersonationRefused(code int) bool {
+	return code == http.StatusBadRequest || code == http.StatusUnauthorized || code == http.StatusForbidden
+}
+
+// impersonationTokenSource returns the token source acting on behalf of the user set by
+// WithExecuteAs, for the instance of the base URL
+func (c *Client) impersonationTokenSource(hc *http.Client) *impersonationTokenSource {
+	tokenURL := c.impersonationTokenURL
+	if tokenURL == "" {
+		tokenURL = impersonationTokenURL
+	}
+	return &impersonationTokenSource{
+		ctx:       c.ctx,
+		client:    hc,
+		tokenURL:  tokenURL,
+		app:       c.connectApp,
+		instance:  c.BaseURL.Scheme + "://" + c.BaseURL.Host,
+		accountID: c.executeAs,
+	}
+}
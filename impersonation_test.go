@@ -0,0 +1,178 @@
+package jira
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+// newExecuteAsClient returns a client of the test server executing as the given user, its token
+// being granted by the handler of the returned authorization server
+func newExecuteAsClient(t *testing.T, serverURL, accountID string, grant http.HandlerFunc) (*Client, func()) {
+	auth := httptest.NewServer(grant)
+
+	client, err := NewClient(serverURL+baseURLPath+"/", nil,
+		WithConnectApp("my-client-id", "my-secret", "READ", "WRITE"),
+		WithExecuteAs(accountID),
+		func(c *Client) error {
+			c.impersonationTokenURL = auth.URL + "/oauth2/token"
+			return nil
+		})
+	assert.Nil(t, err)
+
+	return client, auth.Close
+}
+
+func TestWithExecuteAs(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	grants := 0
+	client, closeAuth := newExecuteAsClient(t, serverURL, "5b10ac8d82e05b22cc7d4ef5", func(w http.ResponseWriter, r *http.Request) {
+		grants++
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "/oauth2/token", r.URL.Path)
+		assert.Nil(t, r.ParseForm())
+		assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "READ WRITE", r.PostForm.Get("scope"))
+
+		parts := strings.Split(r.PostForm.Get("assertion"), ".")
+		if assert.Len(t, parts, 3) {
+			mac := hmac.New(sha256.New, []byte("my-secret"))
+			mac.Write([]byte(parts[0] + "." + parts[1]))
+			assert.Equal(t, base64.RawURLEncoding.EncodeToString(mac.Sum(nil)), parts[2])
+
+			data, _ := base64.RawURLEncoding.DecodeString(parts[1])
+			var claims map[string]interface{}
+			assert.Nil(t, json.Unmarshal(data, &claims))
+			assert.Equal(t, "urn:atlassian:connect:clientid:my-client-id", claims["iss"])
+			assert.Equal(t, "urn:atlassian:connect:useraccountid:5b10ac8d82e05b22cc7d4ef5", claims["sub"])
+			assert.Equal(t, serverURL, claims["tnt"])
+			assert.Equal(t, "https://oauth-2-authorization-server.services.atlassian.com", claims["aud"])
+		}
+
+		fmt.Fprint(w, `{"access_token": "user-token","token_type": "Bearer","expires_in": 900}`)
+	})
+	defer closeAuth()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer user-token", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"id":5}`)
+	})
+	mux.HandleFunc("/api/2/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer user-token", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"key":"MCP-1"}`)
+	})
+
+	_, _, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+
+	_, _, err = client.Issues.GetIssue(context.Background(), "MCP-1", nil)
+	assert.Nil(t, err)
+
+	assert.Equal(t, 1, grants)
+}
+
+func TestWithExecuteAsDenied(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	client, closeAuth := newExecuteAsClient(t, serverURL, "5b10ac8d82e05b22cc7d4ef5", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error": "invalid_grant","error_description": "The app does not have the ACT_AS_USER scope"}`)
+	})
+	defer closeAuth()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request sent without a token")
+	})
+
+	_, _, err := client.Epics.Get(context.Background(), "5")
+	impErr, ok := err.(*ImpersonationError)
+	if assert.True(t, ok, "%v", err) {
+		assert.Equal(t, "5b10ac8d82e05b22cc7d4ef5", impErr.AccountID)
+		assert.Equal(t, http.StatusBadRequest, impErr.Err.StatusCode)
+		assert.Contains(t, string(impErr.Err.Body), "ACT_AS_USER")
+		assert.True(t, impErr.Is(ErrImpersonationDenied))
+		assert.Equal(t, impErr.Err, impErr.Unwrap())
+	}
+}
+
+func TestWithExecuteAsForbidden(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	client, closeAuth := newExecuteAsClient(t, serverURL, "5b10ac8d82e05b22cc7d4ef5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"access_token": "user-token","expires_in": 900}`)
+	})
+	defer closeAuth()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+
+	// the user may not see the epic, which is not an impersonation failure
+	_, _, err := client.Epics.Get(context.Background(), "5")
+	_, ok := err.(*ErrorResponse)
+	assert.True(t, ok)
+}
+
+func TestWithExecuteAsGrantFailure(t *testing.T) {
+	_, _, serverURL, teardown := setup()
+	defer teardown()
+
+	client, closeAuth := newExecuteAsClient(t, serverURL, "5b10ac8d82e05b22cc7d4ef5", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	defer closeAuth()
+
+	_, _, err := client.Epics.Get(context.Background(), "5")
+	_, ok := err.(*TokenError)
+	assert.True(t, ok, "%v", err)
+}
+
+func TestWithExecuteAsOptions(t *testing.T) {
+	_, err := NewClient(defaultBaseURL, nil, WithConnectApp("my-client-id", "my-secret"), WithExecuteAs(""))
+	assert.NotNil(t, err)
+
+	_, err = NewClient(defaultBaseURL, nil, WithConnectApp("", "my-secret"))
+	assert.NotNil(t, err)
+
+	_, err = NewClient(defaultBaseURL, nil, WithExecuteAs("5b10ac8d82e05b22cc7d4ef5"))
+	assert.Equal(t, ErrNoConnectApp, err)
+
+	_, err = NewClient(defaultBaseURL, nil, WithConnectApp("my-client-id", "my-secret"), WithExecuteAs("5b10ac8d82e05b22cc7d4ef5"), WithBearerToken("my-token"))
+	assert.Equal(t, ErrConflictingAuth, err)
+
+	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "my-token"})
+	_, err = NewClient(defaultBaseURL, nil, WithConnectApp("my-client-id", "my-secret"), WithExecuteAs("5b10ac8d82e05b22cc7d4ef5"), WithTokenSource(ts))
+	assert.Equal(t, ErrConflictingAuth, err)
+}
+
+func TestImpersonationAssertionExpiry(t *testing.T) {
+	s := &impersonationTokenSource{app: &connectApp{oauthClientID: "id", sharedSecret: "secret"}, instance: "https://mycompany.atlassian.net", accountID: "5b10"}
+	now := time.Unix(1600000000, 0)
+
+	assertion, err := s.assertion(now)
+	assert.Nil(t, err)
+
+	data, _ := base64.RawURLEncoding.DecodeString(strings.Split(assertion, ".")[1])
+	var claims struct {
+		IssuedAt int64 `json:"iat"`
+		Expiry   int64 `json:"exp"`
+	}
+	assert.Nil(t, json.Unmarshal(data, &claims))
+	assert.Equal(t, int64(1600000000), claims.IssuedAt)
+	assert.Equal(t, int64(1600000060), claims.Expiry)
+}
@@ -0,0 +1,104 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"path"
+)
+
+// IssueLinksService handles communication with the issue link related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/issueLink
+type IssueLinksService service
+
+// IssueLinkTypeWrap represents the list of issue link types
+type IssueLinkTypeWrap struct {
+	IssueLinkTypes []*IssueLinkType `json:"issueLinkTypes,omitempty"`
+}
+
+// NewIssueLink returns a link of the given type, e.g. Blocks, in which the
+// inward issue is linked to the outward issue, both given by key
+func NewIssueLink(linkType, inwardKey, outwardKey string) *IssueLink {
+	return &IssueLink{
+		Type:    &IssueLinkType{Name: linkType},
+		Inward:  &Issue{Key: inwardKey},
+		Outward: &Issue{Key: outwardKey},
+	}
+}
+
+// Create creates a link between two issues. The type of the link is given by its
+// name or id. It returns the id of the link created, read from the Location header
+// of the response, which is empty if Jira does not return it.
+//
+// POST /rest/api/2/issueLink
+func (i *IssueLinksService) Create(ctx context.Context, link *IssueLink) (string, *Response, error) {
+
+	req, err := i.client.NewRequest("POST", i.client.apiPath("issueLink"), link)
+	if err != nil {
+		return "", nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if err != nil {
+		return "", resp, err
+	}
+
+	var id string
+	if loc, err := resp.Location(); err == nil {
+		id = path.Base(loc.Path)
+	}
+
+	return id, resp, nil
+}
+
+// Get returns an issue link, for a given link Id.
+//
+// GET /rest/api/2/issueLink/{linkId}
+func (i *IssueLinksService) Get(ctx context.Context, linkID string) (*IssueLink, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", i.client.apiPath(fmt.Sprintf("issueLink/%s", linkID)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var link = &IssueLink{}
+	resp, err := i.client.Do(ctx, req, link)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return link, resp, nil
+}
+
+// Delete deletes an issue link, for a given link Id.
+//
+// DELETE /rest/api/2/issueLink/{linkId}
+func (i *IssueLinksService) Delete(ctx context.Context, linkID string) (*Response, error) {
+
+	req, err := i.client.NewRequest("DELETE", i.client.apiPath(fmt.Sprintf("issueLink/%s", linkID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.client.Do(ctx, req, nil)
+}
+
+// ListTypes returns all issue link types, whose names can be used to create links.
+//
+// GET /rest/api/2/issueLinkType
+func (i *IssueLinksService) ListTypes(ctx context.Context) ([]*IssueLinkType, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", i.client.apiPath("issueLinkType"), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &IssueLinkTypeWrap{}
+	resp, err := i.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wrap.IssueLinkTypes, resp, nil
+}
@@ -0,0 +1,81 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssueLinksServiceCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issueLink", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		link := &IssueLink{}
+		json.NewDecoder(r.Body).Decode(link)
+		assert.Equal(t, "Blocks", link.Type.Name)
+		assert.Equal(t, "MCP-1", link.Inward.Key)
+		assert.Equal(t, "MCP-2", link.Outward.Key)
+
+		w.Header().Set("Location", "https://jira.mycompany.com/rest/api/2/issueLink/10001")
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	id, resp, err := client.IssueLinks.Create(context.Background(), NewIssueLink("Blocks", "MCP-1", "MCP-2"))
+	assert.Nil(t, err)
+	assert.Equal(t, "10001", id)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+}
+
+func TestIssueLinksServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issueLink/10001", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": "10001","type": {"id": "1000","name": "Blocks"},"inwardIssue": {"key": "MCP-1"},"outwardIssue": {"key": "MCP-2"}}`)
+	})
+
+	link, _, err := client.IssueLinks.Get(context.Background(), "10001")
+	assert.Nil(t, err)
+	assert.Equal(t, "10001", link.ID)
+	assert.Equal(t, "Blocks", link.Type.Name)
+	assert.Equal(t, "MCP-1", link.Inward.Key)
+	assert.Equal(t, "MCP-2", link.Outward.Key)
+}
+
+func TestIssueLinksServiceDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issueLink/10001", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.IssueLinks.Delete(context.Background(), "10001")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestIssueLinksServiceListTypes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issueLinkType", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"issueLinkTypes": [{"id": "1000","name": "Blocks","inward": "is blocked by","outward": "blocks"}]}`)
+	})
+
+	types, _, err := client.IssueLinks.ListTypes(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, types, 1)
+	assert.Equal(t, "Blocks", types[0].Name)
+	assert.Equal(t, "is blocked by", types[0].Inward)
+}
@@ -0,0 +1,112 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// FieldMeta describes a field that can be set when creating or editing an issue
+type FieldMeta struct {
+	Required        bool                     `json:"required,omitempty"`
+	Schema          *FieldSchema             `json:"schema,omitempty"`
+	Name            string                   `json:"name,omitempty"`
+	Key             string                   `json:"key,omitempty"`
+	AutoCompleteURL string                   `json:"autoCompleteUrl,omitempty"`
+	HasDefaultValue bool                     `json:"hasDefaultValue,omitempty"`
+	Operations      []string                 `json:"operations,omitempty"`
+	AllowedValues   []map[string]interface{} `json:"allowedValues,omitempty"`
+}
+
+// CreateMetaWrap represents the data returned by the create metadata API
+type CreateMetaWrap struct {
+	Expand   string               `json:"expand,omitempty"`
+	Projects []*CreateMetaProject `json:"projects,omitempty"`
+}
+
+// CreateMetaProject represents a project in which issues can be created, with its issue types
+type CreateMetaProject struct {
+	ID         string                 `json:"id,omitempty"`
+	Key        string                 `json:"key,omitempty"`
+	Name       string                 `json:"name,omitempty"`
+	SelfLink   string                 `json:"self,omitempty"`
+	IssueTypes []*CreateMetaIssueType `json:"issuetypes,omitempty"`
+}
+
+// CreateMetaIssueType represents an issue type that can be created, with its fields indexed by id
+type CreateMetaIssueType struct {
+	ID          string                `json:"id,omitempty"`
+	Name        string                `json:"name,omitempty"`
+	SelfLink    string                `json:"self,omitempty"`
+	Description string                `json:"description,omitempty"`
+	SubTask     bool                  `json:"subtask,omitempty"`
+	Fields      map[string]*FieldMeta `json:"fields,omitempty"`
+}
+
+// issueEditMetaWrap represents the data returned by the edit metadata API
+type issueEditMetaWrap struct {
+	Fields map[string]*FieldMeta `json:"fields,omitempty"`
+}
+
+// CreateMetaOptions contains all options to get the create metadata
+type CreateMetaOptions struct {
+	//List of project ids. This parameter can be combined with ProjectKeys.
+	ProjectIDs []string `query:"projectIds"`
+	//List of project keys. This parameter can be combined with ProjectIDs.
+	ProjectKeys []string `query:"projectKeys"`
+	//List of issue type ids. This parameter can be combined with IssueTypeNames.
+	IssueTypeIDs []string `query:"issuetypeIds"`
+	//List of issue type names. This parameter can be combined with IssueTypeIDs.
+	IssueTypeNames []string `query:"issuetypeNames"`
+	//Use projects.issuetypes.fields, the default, to include the fields of each issue type.
+	Expand string `query:"expand"`
+}
+
+// GetCreateMeta returns the projects and issue types in which the user can create issues, with the
+// fields of each issue type, whether they are required, their schema and allowed values. The projects
+// and issue types can be filtered by opts. The fields are returned unless another opts.Expand is given.
+//
+// GET /rest/api/2/issue/createmeta
+func (i *IssuesService) GetCreateMeta(ctx context.Context, opts *CreateMetaOptions) ([]*CreateMetaProject, *Response, error) {
+	var o CreateMetaOptions
+	if opts != nil {
+		o = *opts
+	}
+	if o.Expand == "" {
+		o.Expand = "projects.issuetypes.fields"
+	}
+
+	q := QueryParameters(&o)
+
+	req, err := i.client.NewRequest("GET", i.client.apiPath(fmt.Sprintf("issue/createmeta%s", q)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &CreateMetaWrap{}
+	resp, err := i.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wrap.Projects, resp, nil
+}
+
+// GetEditMeta returns the fields of an issue that the user can edit, for a given issue Id or issue key,
+// indexed by field id, with whether they are required, their schema, operations and allowed values.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/editmeta
+func (i *IssuesService) GetEditMeta(ctx context.Context, idOrKey string) (map[string]*FieldMeta, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", i.client.apiPath(fmt.Sprintf("issue/%s/editmeta", idOrKey)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &issueEditMetaWrap{}
+	resp, err := i.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wrap.Fields, resp, nil
+}
@@ -0,0 +1,62 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuesServiceGetCreateMeta(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/createmeta", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "MCP", r.URL.Query().Get("projectKeys"))
+		assert.Equal(t, "Epic", r.URL.Query().Get("issuetypeNames"))
+		assert.Equal(t, "projects.issuetypes.fields", r.URL.Query().Get("expand"))
+		fmt.Fprint(w, `{"projects": [{"id": "10000","key": "MCP","name": "My Company Project","issuetypes": [{
+			"id": "10000","name": "Epic","subtask": false,
+			"fields": {
+				"summary": {"required": true,"schema": {"type": "string","system": "summary"},"name": "Summary","key": "summary","operations": ["set"]},
+				"customfield_10011": {"required": true,"schema": {"type": "string","custom": "com.pyxis.greenhopper.jira:gh-epic-label","customId": 10011},"name": "Epic Name","key": "customfield_10011"},
+				"priority": {"required": false,"schema": {"type": "priority","system": "priority"},"name": "Priority","allowedValues": [{"id": "1","name": "Highest"},{"id": "2","name": "High"}]}
+			}
+		}]}]}`)
+	})
+
+	projects, _, err := client.Issues.GetCreateMeta(context.Background(), &CreateMetaOptions{ProjectKeys: []string{"MCP"}, IssueTypeNames: []string{"Epic"}})
+	assert.Nil(t, err)
+	assert.Len(t, projects, 1)
+	assert.Equal(t, "MCP", projects[0].Key)
+
+	fields := projects[0].IssueTypes[0].Fields
+	assert.Len(t, fields, 3)
+	assert.True(t, fields["summary"].Required)
+	assert.Equal(t, "com.pyxis.greenhopper.jira:gh-epic-label", fields["customfield_10011"].Schema.Custom)
+	assert.False(t, fields["priority"].Required)
+	assert.Equal(t, "Highest", fields["priority"].AllowedValues[0]["name"])
+}
+
+func TestIssuesServiceGetEditMeta(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/editmeta", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"fields": {
+			"summary": {"required": true,"schema": {"type": "string","system": "summary"},"name": "Summary","operations": ["set"]},
+			"labels": {"required": false,"schema": {"type": "array","items": "string","system": "labels"},"name": "Labels","autoCompleteUrl": "https://jira.mycompany.com/rest/api/1.0/labels/suggest?query=","operations": ["add","set","remove"]}
+		}}`)
+	})
+
+	fields, _, err := client.Issues.GetEditMeta(context.Background(), "MCP-1")
+	assert.Nil(t, err)
+	assert.Len(t, fields, 2)
+	assert.True(t, fields["summary"].Required)
+	assert.Equal(t, []string{"add", "set", "remove"}, fields["labels"].Operations)
+	assert.Equal(t, "string", fields["labels"].Schema.Items)
+}
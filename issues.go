@@ -3,7 +3,9 @@ package jira
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 	"time"
 )
@@ -14,31 +16,57 @@ import (
 // Jira Agile API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/issue
 type IssuesService service
 
+// dateTimeLayout is the layout of the times returned by the API
+const dateTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+// dateTimeLayouts are the layouts accepted when decoding a DateTime. Besides dateTimeLayout,
+// some endpoints omit the milliseconds or use a colon in the offset, e.g. +10:00 or Z.
+var dateTimeLayouts = []string{
+	dateTimeLayout,
+	"2006-01-02T15:04:05-0700",
+	"2006-01-02T15:04:05.000Z07:00",
+	time.RFC3339Nano,
+}
+
 // DateTime represents a time in 2006-01-02T15:04:05.000-0700 format
 type DateTime time.Time
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// The time is expected to be a quoted string in 2006-01-02T15:04:05.000-0700 format.
+// The time is expected to be a quoted string in 2006-01-02T15:04:05.000-0700 format,
+// though the milliseconds can be omitted and the offset can be in RFC 3339 format.
 func (d *DateTime) UnmarshalJSON(b []byte) error {
 	s := strings.Trim(string(b), "\"")
 
-	if s == "null" {
+	if s == "null" || s == "" {
 		*d = DateTime(time.Time{})
 		return nil
 	}
 
-	t, err := time.Parse("2006-01-02T15:04:05.000-0700", s)
-	if err != nil {
-		return err
+	var err error
+	for _, layout := range dateTimeLayouts {
+		var t time.Time
+		if t, err = time.Parse(layout, s); err == nil {
+			*d = DateTime(t)
+			return nil
+		}
 	}
-	*d = DateTime(t)
-	return nil
+	return err
+}
+
+// Time returns the time.Time value of the DateTime
+func (d DateTime) Time() time.Time {
+	return time.Time(d)
 }
 
 // MarshalJSON implements the json.Marshaler interface.
-// The time is a quoted string in 2006-01-02T15:04:05.000-0700 format
+// The time is a quoted string in 2006-01-02T15:04:05.000-0700 format, or null if it is zero.
 func (d DateTime) MarshalJSON() ([]byte, error) {
-	return json.Marshal(d)
+	t := time.Time(d)
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"` + t.Format(dateTimeLayout) + `"`), nil
 }
 
 // IssueWrap represents the data returned by the API,
@@ -56,12 +84,15 @@ type Issue struct {
 	SelfLink string      `json:"self,omitempty"`
 	Expand   string      `json:"expand,omitempty"`
 	Fields   *IssueField `json:"fields,omitempty"`
+	// Changelog is only returned when the changelog is expanded
+	Changelog *IssueChangelogWrap `json:"changelog,omitempty"`
 }
 
 // IssueField represents the fields of Jira Issue
 type IssueField struct {
 	Flagged                       bool               `json:"flagged,omitempty"`
 	Description                   string             `json:"description,omitempty"`
+	DescriptionDocument           *ADFDocument       `json:"-"`
 	Sprint                        *Sprint            `json:"sprint,omitempty"`
 	ClosedSprints                 []*Sprint          `json:"closedSprints,omitempty"`
 	Project                       *Project           `json:"project,omitempty"`
@@ -73,6 +104,7 @@ type IssueField struct {
 	SubTasks                      []*Issue           `json:"subtasks,omitempty"`
 	Type                          IssueType          `json:"issuetype,omitempty"`
 	Environment                   string             `json:"environment,omitempty"`
+	EnvironmentDocument           *ADFDocument       `json:"-"`
 	TimeEstimate                  int                `json:"timeestimate,omitempty"`
 	AggregateTimeSpent            int                `json:"aggregatetimespent,omitempty"`
 	WorkRatio                     int                `json:"workratio,omitempty"`
@@ -102,6 +134,28 @@ type IssueField struct {
 	Versions                      []*IssueVersion    `json:"versions,omitempty"`
 }
 
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The description and the environment are decoded either as plain strings or as ADF documents.
+func (f *IssueField) UnmarshalJSON(b []byte) error {
+	type field IssueField
+	aux := &struct {
+		*field
+		Description json.RawMessage `json:"description,omitempty"`
+		Environment json.RawMessage `json:"environment,omitempty"`
+	}{field: (*field)(f)}
+
+	if err := json.Unmarshal(b, aux); err != nil {
+		return err
+	}
+
+	var err error
+	if f.Description, f.DescriptionDocument, err = decodeRichText(aux.Description); err != nil {
+		return err
+	}
+	f.Environment, f.EnvironmentDocument, err = decodeRichText(aux.Environment)
+	return err
+}
+
 // IssueType represents the type of Jira Issue
 type IssueType struct {
 	ID          string `json:"id,omitempty"`
@@ -119,6 +173,7 @@ type IssueResolution struct {
 	Name        string `json:"name,omitempty"`
 	SelfLink    string `json:"self,omitempty"`
 	Description string `json:"description,omitempty"`
+	IconURL     string `json:"iconUrl,omitempty"`
 }
 
 // IssueLink represents the links of Jira Issue
@@ -151,6 +206,7 @@ type IssueTimeTracking struct {
 
 // IssueUser represents the user of Jira Issue
 type IssueUser struct {
+	AccountID   string            `json:"accountId,omitempty"`
 	Key         string            `json:"key,omitempty"`
 	Name        string            `json:"name,omitempty"`
 	SelfLink    string            `json:"self,omitempty"`
@@ -166,14 +222,18 @@ type IssueWatch struct {
 	SelfLink string `json:"self,omitempty"`
 	Count    int    `json:"watchCount,omitempty"`
 	Watching bool   `json:"isWatching,omitempty"`
+	// Watchers is only returned by WatchersService.List
+	Watchers []*IssueUser `json:"watchers,omitempty"`
 }
 
 // IssuePriority represents the priority of Jira Issue
 type IssuePriority struct {
-	ID       string `json:"id,omitempty"`
-	Name     string `json:"name,omitempty"`
-	SelfLink string `json:"self,omitempty"`
-	IconURL  string `json:"iconUrl,omitempty"`
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	SelfLink    string `json:"self,omitempty"`
+	IconURL     string `json:"iconUrl,omitempty"`
+	Description string `json:"description,omitempty"`
+	StatusColor string `json:"statusColor,omitempty"`
 }
 
 // IssueAttachment represents the attachments list of Jira Issue
@@ -193,6 +253,8 @@ type IssueVote struct {
 	SelfLink string `json:"self,omitempty"`
 	Votes    int    `json:"votes,omitempty"`
 	Voted    bool   `json:"hasVoted,omitempty"`
+	// Voters is only returned by VotesService.Get
+	Voters []*IssueUser `json:"voters,omitempty"`
 }
 
 // IssueWorklogWrap represents the worklog list of Jira Issue
@@ -201,19 +263,39 @@ type IssueWorklogWrap struct {
 	Worklogs []*IssueWorklog `json:"worklogs,omitempty"`
 }
 
-// IssueWorklog represents the worklog of Jira Issue
+// IssueWorklog represents the worklog of Jira Issue. When the version 3 of the API
+// is used, the comment is an ADF document available in CommentDocument.
 type IssueWorklog struct {
-	ID               string     `json:"id,omitempty"`
-	IssueID          string     `json:"issueId,omitempty"`
-	SelfLink         string     `json:"self,omitempty"`
-	Author           *IssueUser `json:"author,omitempty"`
-	UpdateAuthor     *IssueUser `json:"updateAuthor,omitempty"`
-	Comment          string     `json:"comment,omitempty"`
-	CreatedAt        DateTime   `json:"created,omitempty"`
-	UpdatedAt        DateTime   `json:"updated,omitempty"`
-	StartedAt        DateTime   `json:"started,omitempty"`
-	TimeSpent        string     `json:"timeSpent,omitempty"`
-	TimeSpentSeconds int        `json:"timeSpentSeconds,omitempty"`
+	ID               string       `json:"id,omitempty"`
+	IssueID          string       `json:"issueId,omitempty"`
+	SelfLink         string       `json:"self,omitempty"`
+	Author           *IssueUser   `json:"author,omitempty"`
+	UpdateAuthor     *IssueUser   `json:"updateAuthor,omitempty"`
+	Comment          string       `json:"comment,omitempty"`
+	CommentDocument  *ADFDocument `json:"-"`
+	CreatedAt        DateTime     `json:"created,omitempty"`
+	UpdatedAt        DateTime     `json:"updated,omitempty"`
+	StartedAt        DateTime     `json:"started,omitempty"`
+	TimeSpent        string       `json:"timeSpent,omitempty"`
+	TimeSpentSeconds int          `json:"timeSpentSeconds,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The comment is decoded either as a plain string or as an ADF document.
+func (w *IssueWorklog) UnmarshalJSON(b []byte) error {
+	type worklog IssueWorklog
+	aux := &struct {
+		*worklog
+		Comment json.RawMessage `json:"comment,omitempty"`
+	}{worklog: (*worklog)(w)}
+
+	if err := json.Unmarshal(b, aux); err != nil {
+		return err
+	}
+
+	var err error
+	w.Comment, w.CommentDocument, err = decodeRichText(aux.Comment)
+	return err
 }
 
 // IssueStatus represents the status of Jira Issue
@@ -242,21 +324,82 @@ type IssueProgress struct {
 	Percent  int `json:"percent,omitempty"`
 }
 
+// IssueChangelogWrap represents the change history of Jira Issue. The dedicated endpoint
+// returns the entries in Values, while the expanded changelog of an issue returns them in Histories.
+type IssueChangelogWrap struct {
+	Pagination
+	Values    []*IssueChangelog `json:"values,omitempty"`
+	Histories []*IssueChangelog `json:"histories,omitempty"`
+}
+
+// IssueChangelog represents a change of Jira Issue, made by a user at a given time
+type IssueChangelog struct {
+	ID      string                `json:"id,omitempty"`
+	Author  *IssueUser            `json:"author,omitempty"`
+	Created DateTime              `json:"created,omitempty"`
+	Items   []*IssueChangelogItem `json:"items,omitempty"`
+}
+
+// IssueChangelogItem represents the change of a field of Jira Issue
+type IssueChangelogItem struct {
+	Field      string `json:"field,omitempty"`
+	FieldType  string `json:"fieldtype,omitempty"`
+	FieldID    string `json:"fieldId,omitempty"`
+	From       string `json:"from,omitempty"`
+	FromString string `json:"fromString,omitempty"`
+	To         string `json:"to,omitempty"`
+	ToString   string `json:"toString,omitempty"`
+}
+
 // IssueCommentWrap represents the comments list of Jira Issue
 type IssueCommentWrap struct {
 	Pagination
 	Comments []*IssueComment `json:"comments,omitempty"`
 }
 
-// IssueComment represents the comment of Jira Issue
+// IssueComment represents the comment of Jira Issue. When the version 3 of the API
+// is used, the body is an ADF document available in Document.
 type IssueComment struct {
-	ID           string    `json:"id,omitempty"`
-	SelfLink     string    `json:"self,omitempty"`
-	Body         string    `json:"body,omitempty"`
-	Author       IssueUser `json:"author,omitempty"`
-	UpdateAuthor IssueUser `json:"updateAuthor,omitempty"`
-	CreatedAt    DateTime  `json:"created,omitempty"`
-	UpdatedAt    DateTime  `json:"updated,omitempty"`
+	ID           string                  `json:"id,omitempty"`
+	SelfLink     string                  `json:"self,omitempty"`
+	Body         string                  `json:"body,omitempty"`
+	Document     *ADFDocument            `json:"-"`
+	Author       IssueUser               `json:"author,omitempty"`
+	UpdateAuthor IssueUser               `json:"updateAuthor,omitempty"`
+	CreatedAt    DateTime                `json:"created,omitempty"`
+	UpdatedAt    DateTime                `json:"updated,omitempty"`
+	Visibility   *IssueCommentVisibility `json:"visibility,omitempty"`
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The body is decoded either as a plain string or as an ADF document.
+func (c *IssueComment) UnmarshalJSON(b []byte) error {
+	type comment IssueComment
+	aux := &struct {
+		*comment
+		Body json.RawMessage `json:"body,omitempty"`
+	}{comment: (*comment)(c)}
+
+	if err := json.Unmarshal(b, aux); err != nil {
+		return err
+	}
+
+	var err error
+	c.Body, c.Document, err = decodeRichText(aux.Body)
+	return err
+}
+
+// IssueCommentVisibility represents the visibility restriction of a comment
+type IssueCommentVisibility struct {
+	//Valid values: group, role
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// newIssueComment contains the fields sent to add a comment
+type newIssueComment struct {
+	Body       interface{}             `json:"body,omitempty"`
+	Visibility *IssueCommentVisibility `json:"visibility,omitempty"`
 }
 
 // IssueComponent represents the component of Jira Issue
@@ -264,6 +407,13 @@ type IssueComponent struct {
 	ID       string `json:"id,omitempty"`
 	Name     string `json:"name,omitempty"`
 	SelfLink string `json:"self,omitempty"`
+	// The fields below are only returned by ComponentsService
+	Description   string     `json:"description,omitempty"`
+	Lead          *IssueUser `json:"lead,omitempty"`
+	LeadAccountID string     `json:"leadAccountId,omitempty"`
+	AssigneeType  string     `json:"assigneeType,omitempty"`
+	Project       string     `json:"project,omitempty"`
+	ProjectID     int        `json:"projectId,omitempty"`
 }
 
 // IssueVersion represents the version of Jira Issue
@@ -274,6 +424,13 @@ type IssueVersion struct {
 	Description string `json:"description,omitempty"`
 	Archived    bool   `json:"archived,omitempty"`
 	Released    bool   `json:"released,omitempty"`
+	// The fields below are only returned by VersionsService. The dates are formatted as
+	// yyyy-mm-dd, e.g. 2020-07-06
+	StartDate   string `json:"startDate,omitempty"`
+	ReleaseDate string `json:"releaseDate,omitempty"`
+	Overdue     bool   `json:"overdue,omitempty"`
+	Project     string `json:"project,omitempty"`
+	ProjectID   int    `json:"projectId,omitempty"`
 }
 
 // IssueEstimation represents the estimation of the issue and a fieldId of the field that is used for it
@@ -282,12 +439,249 @@ type IssueEstimation struct {
 	Value   int    `json:"value,omitempty"`
 }
 
+// MaxIssuesPerMove is the maximum number of issues that can be moved in one operation
+const MaxIssuesPerMove = 50
+
+var (
+	// ErrNoIssues is returned when no issue keys are given to be moved
+	ErrNoIssues = errors.New("jira: no issues to move")
+	// ErrTooManyIssues is returned when more than MaxIssuesPerMove issue keys are given to be moved
+	ErrTooManyIssues = fmt.Errorf("jira: at most %d issues can be moved at once", MaxIssuesPerMove)
+)
+
+// IssueRequest contains the fields to create an issue. The fields are sent as given,
+// the helpers set the most common ones
+type IssueRequest struct {
+	Fields map[string]interface{} `json:"fields"`
+	Update map[string]interface{} `json:"update,omitempty"`
+}
+
+// NewIssueRequest returns a new IssueRequest with the given project key, issue type name and summary
+func NewIssueRequest(projectKey, issueType, summary string) *IssueRequest {
+	return (&IssueRequest{}).SetProject(projectKey).SetIssueType(issueType).SetSummary(summary)
+}
+
+// SetField sets the value of a field, e.g. a custom field
+func (r *IssueRequest) SetField(id string, value interface{}) *IssueRequest {
+	if r.Fields == nil {
+		r.Fields = make(map[string]interface{})
+	}
+	r.Fields[id] = value
+	return r
+}
+
+// SetProject sets the project of the issue, for a given project key
+func (r *IssueRequest) SetProject(key string) *IssueRequest {
+	return r.SetField("project", map[string]string{"key": key})
+}
+
+// SetSummary sets the summary of the issue
+func (r *IssueRequest) SetSummary(summary string) *IssueRequest {
+	return r.SetField("summary", summary)
+}
+
+// SetIssueType sets the type of the issue, for a given issue type name
+func (r *IssueRequest) SetIssueType(name string) *IssueRequest {
+	return r.SetField("issuetype", map[string]string{"name": name})
+}
+
+// SetAssignee sets the assignee of the issue, for a given account id
+func (r *IssueRequest) SetAssignee(accountID string) *IssueRequest {
+	return r.SetField("assignee", map[string]string{"accountId": accountID})
+}
+
+// IssueUpdate contains the changes of an issue update. Fields overwrites the value of the
+// given fields, while Update applies operations (set, add, remove, edit) on them, e.g. to
+// add a label without changing the others. A field cannot be in both
+type IssueUpdate struct {
+	Fields map[string]interface{}              `json:"fields,omitempty"`
+	Update map[string][]map[string]interface{} `json:"update,omitempty"`
+}
+
+// SetField overwrites the value of a field
+func (u *IssueUpdate) SetField(id string, value interface{}) *IssueUpdate {
+	if u.Fields == nil {
+		u.Fields = make(map[string]interface{})
+	}
+	u.Fields[id] = value
+	return u
+}
+
+// AddOperation appends an operation on a field, e.g. AddOperation("labels", "add", "triaged")
+// or AddOperation("components", "remove", map[string]string{"name": "UI"})
+func (u *IssueUpdate) AddOperation(id, operation string, value interface{}) *IssueUpdate {
+	if u.Update == nil {
+		u.Update = make(map[string][]map[string]interface{})
+	}
+	u.Update[id] = append(u.Update[id], map[string]interface{}{operation: value})
+	return u
+}
+
+// richTextFields are the system fields of an issue holding rich text, sent as ADF documents
+// on the version 3 of the API
+var richTextFields = []string{"description", "environment"}
+
+// adfFields returns a copy of fields whose rich text fields given as plain text are converted
+// to ADF documents, when the version 3 of the API is used. Otherwise, fields is returned as is.
+func (c *Client) adfFields(fields map[string]interface{}) map[string]interface{} {
+	if c.version() != "3" || fields == nil {
+		return fields
+	}
+
+	converted := make(map[string]interface{}, len(fields))
+	for id, value := range fields {
+		converted[id] = value
+	}
+	for _, id := range richTextFields {
+		if text, ok := fields[id].(string); ok {
+			converted[id] = TextToADF(text)
+		}
+	}
+	return converted
+}
+
+// adfOperations returns a copy of the operations whose set operations of the rich text fields
+// given as plain text are converted to ADF documents, as done by adfFields
+func (c *Client) adfOperations(operations map[string][]map[string]interface{}) map[string][]map[string]interface{} {
+	if c.version() != "3" || operations == nil {
+		return operations
+	}
+
+	converted := make(map[string][]map[string]interface{}, len(operations))
+	for id, ops := range operations {
+		converted[id] = ops
+	}
+	for _, id := range richTextFields {
+		ops, ok := operations[id]
+		if !ok {
+			continue
+		}
+		converted[id] = make([]map[string]interface{}, len(ops))
+		for n, op := range ops {
+			converted[id][n] = adfOperation(op)
+		}
+	}
+	return converted
+}
+
+// adfOperation returns a copy of the operation whose set value given as plain text is
+// converted to an ADF document
+func adfOperation(op map[string]interface{}) map[string]interface{} {
+	text, ok := op["set"].(string)
+	if !ok {
+		return op
+	}
+
+	converted := make(map[string]interface{}, len(op))
+	for name, value := range op {
+		converted[name] = value
+	}
+	converted["set"] = TextToADF(text)
+	return converted
+}
+
+// adfIssueRequest returns the issue request with its rich text fields sent as ADF documents,
+// when the version 3 of the API is used, see adfFields
+func (c *Client) adfIssueRequest(issue *IssueRequest) *IssueRequest {
+	if issue == nil || c.version() != "3" {
+		return issue
+	}
+	return &IssueRequest{Fields: c.adfFields(issue.Fields), Update: issue.Update}
+}
+
+// BulkCreateResult contains the issues created by a bulk operation and
+// the errors of the issues that could not be created
+type BulkCreateResult struct {
+	Issues []*Issue            `json:"issues,omitempty"`
+	Errors []*BulkCreateFailed `json:"errors,omitempty"`
+}
+
+// BulkCreateFailed contains the error of an issue that could not be created,
+// FailedElementNumber is the index of the issue in the request
+type BulkCreateFailed struct {
+	Status              int                `json:"status,omitempty"`
+	FailedElementNumber int                `json:"failedElementNumber"`
+	ElementErrors       *BulkCreateMessage `json:"elementErrors,omitempty"`
+}
+
+// BulkCreateMessage contains the messages of an issue that could not be created
+type BulkCreateMessage struct {
+	Messages []string          `json:"errorMessages,omitempty"`
+	Errors   map[string]string `json:"errors,omitempty"`
+}
+
+// ErrEmptyTransitionID is returned when no transition id is given to transition an issue
+var ErrEmptyTransitionID = errors.New("jira: transition id is required")
+
+// AssigneeAutomatic can be given to IssuesService.AssignIssue to assign the issue to the default assignee of the project
+const AssigneeAutomatic = "-1"
+
+// ErrIssueOrUserNotFound is matched, e.g. by errors.Is, by the *AssignError returned by
+// IssuesService.AssignIssue when either the issue or the user does not exist
+var ErrIssueOrUserNotFound = errors.New("jira: issue or user not found")
+
+// AssignError is returned by IssuesService.AssignIssue when Jira responds 404 Not Found,
+// either the issue or the user not existing.
+type AssignError struct {
+	// IssueIDOrKey is the issue Id or key given to AssignIssue
+	IssueIDOrKey string
+	// AccountID is the account id of the assignee given to AssignIssue
+	AccountID string
+	Err       *ErrorResponse
+}
+
+func (e *AssignError) Error() string {
+	return fmt.Sprintf("%v: %v", ErrIssueOrUserNotFound, e.Err)
+}
+
+// Unwrap returns the error returned by the API
+func (e *AssignError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether target is ErrIssueOrUserNotFound
+func (e *AssignError) Is(target error) bool {
+	return target == ErrIssueOrUserNotFound
+}
+
+// IssueTransitionWrap represents the transitions list of Jira Issue
+type IssueTransitionWrap struct {
+	Expand      string             `json:"expand,omitempty"`
+	Transitions []*IssueTransition `json:"transitions,omitempty"`
+}
+
+// IssueTransition represents a workflow transition of Jira Issue
+type IssueTransition struct {
+	ID   string       `json:"id,omitempty"`
+	Name string       `json:"name,omitempty"`
+	To   *IssueStatus `json:"to,omitempty"`
+}
+
+// issueTransitionRequest contains the fields sent to transition an issue
+type issueTransitionRequest struct {
+	Transition *IssueTransition       `json:"transition"`
+	Fields     map[string]interface{} `json:"fields,omitempty"`
+}
+
 // IssueKeys contains the issue key to perform the actions
 // For example: EpicsServices.MoveIssuesTo(...), SprintsService.MoveIssuesTo(...)
 type IssueKeys struct {
 	Issues []string `json:"issues,omitempty"`
 }
 
+// validate checks the number of issue keys against the limits of the move operations
+func (k *IssueKeys) validate() error {
+	if k == nil || len(k.Issues) == 0 {
+		return ErrNoIssues
+	}
+
+	if len(k.Issues) > MaxIssuesPerMove {
+		return ErrTooManyIssues
+	}
+
+	return nil
+}
+
 // IssueRank contains the fields for ranking issues
 type IssueRank struct {
 	Issues            []string `json:"issues,omitempty"`
@@ -315,17 +709,37 @@ type IssuesOptions struct {
 	StartAt int `query:"startAt"`
 	//The maximum number of sprints to return per page. Default: 50. See the 'Pagination' section at the top of this page for more details.
 	MaxResults int `query:"maxResults"`
-	//Filters results using a JQL query. If you define an order in your JQL query, it will override the default order of the returned issues.
-	JQL string `query:"jql"`
+	//Filters results using a JQL query, ANDed by the server with the implicit filter of the endpoint, e.g. the issues of the epic,
+	//such as "sprint in openSprints()" or "resolution is EMPTY". It is URL-encoded, and omitted when blank. If you define an order
+	//in your JQL query, it will override the default order of the returned issues.
+	JQL string `query:"jql,escape"`
+	//Orders the issues returned by EpicsService.ListIssues and ListIssuesWithoutEpic, appended to JQL as an ORDER BY clause,
+	//e.g. "created DESC" or "priority DESC, key". The fields orderable in JQL are honored, e.g. rank, created, updated,
+	//priority, key, status and duedate. By default, the issues are ordered by rank. It can not be used with a JQL ordering the issues.
+	OrderBy string `query:"-"`
 	//Specifies whether to validate the JQL query or not. Default: true.
 	ValidateQuery bool `query:"validateQuery"`
-	//The list of fields to return for each issue. By default, all navigable and Agile fields are returned.
-	Fields string `query:"fields"`
+	//The list of fields to return for each issue, e.g. []string{"summary", "status"}, sent as fields=summary,status.
+	//Restricting the fields reduces the size of the pages and their decoding time. By default, or when empty,
+	//all navigable and Agile fields are returned.
+	Fields []string `query:"fields"`
 	//This parameter is currently not used.
 	Expand string `query:"expand"`
+	//Drops the issues returned more than once by EpicsService.ListIssuesAll and ListIssuesWithoutEpicAll,
+	//e.g. when the issues are reranked while the pages are fetched. It is not sent to the API.
+	Dedup bool `query:"-"`
+	//Reports the progress of EpicsService.ListIssuesAll and ListIssuesWithoutEpicAll after each page, see Progress.
+	//It is not sent to the API.
+	Progress Progress `query:"-"`
+	//The position from which EpicsService.ListIssuesAll and ListIssuesWithoutEpicAll resume the listing, advanced
+	//after each page, so that it can be persisted to resume later, e.g. after a page failed. A zero cursor starts
+	//from the first issue. ErrInvalidCursor is returned when it belongs to another listing, and ErrStaleCursor when
+	//the issues before it have changed. Nothing is listed from a done cursor. It is not sent to the API.
+	Cursor *PageCursor `query:"-"`
 }
 
-// GetIssueOptions contains the options to get an issue
+// GetIssueOptions contains the options of Get, to get an issue from the Jira Agile API,
+// see PlatformIssueOptions for GetIssue
 type GetIssueOptions struct {
 	//The list of fields to return for each issue. By default, all navigable and Agile fields are returned.
 	Fields string `query:"fields"`
@@ -333,6 +747,47 @@ type GetIssueOptions struct {
 	Expand string `query:"expand"`
 }
 
+// CommentsOptions contains all options to list the comments of an issue
+type CommentsOptions struct {
+	//The index of the first comment to return (0-based)
+	StartAt int `query:"startAt"`
+	//The maximum number of comments to return. Default: 50.
+	MaxResults int `query:"maxResults"`
+	//Ordering of the results by the created date. Valid values: created, -created.
+	OrderBy string `query:"orderBy"`
+	//Expands the comments. Valid values: renderedBody.
+	Expand string `query:"expand"`
+}
+
+// ChangelogOptions contains all options to get the change history of an issue
+type ChangelogOptions struct {
+	//The index of the first change to return (0-based)
+	StartAt int `query:"startAt"`
+	//The maximum number of changes to return per page. Default: 100.
+	MaxResults int `query:"maxResults"`
+}
+
+// PlatformIssueOptions contains the options of GetIssue, to get an issue from the Jira platform
+// API. Unlike GetIssueOptions, used by the Agile Get, its fields are lists.
+type PlatformIssueOptions struct {
+	//The list of fields to return for the issue. By default, all fields are returned.
+	Fields []string `query:"fields"`
+	//The list of the parameters to expand, e.g. renderedFields, names, changelog.
+	Expand []string `query:"expand"`
+	//The list of issue properties to return for the issue. By default, no properties are returned.
+	Properties []string `query:"properties"`
+}
+
+// IssueUpdateOptions contains the options to update an issue
+type IssueUpdateOptions struct {
+	//Whether a notification email about the issue update is sent to all watchers. Default: true.
+	NotifyUsers *bool `query:"notifyUsers"`
+	//Whether screen security should be overridden to enable hidden fields to be edited. Admin only.
+	OverrideScreenSecurity bool `query:"overrideScreenSecurity"`
+	//Whether screen security should be overridden to enable uneditable fields to be edited. Admin only.
+	OverrideEditableFlag bool `query:"overrideEditableFlag"`
+}
+
 // IssueEstimationOptions contains the options to set the issue estimation
 type IssueEstimationOptions struct {
 	Value string `json:"value,omitempty"`
@@ -434,3 +889,445 @@ func (i *IssuesService) Rank(ctx context.Context, rank *IssueRank) (*IssueRankEn
 
 	return entries, resp, nil
 }
+
+// ErrNilComment is returned by IssuesService.AddComment when no comment is given
+var ErrNilComment = errors.New("jira: the comment to add is required")
+
+// AddComment adds a comment to an issue, for a given issue Id or issue key. Only the body
+// and the visibility of the comment are sent. It returns the created comment.
+// When the version 3 of the API is used (see WithAPIVersion and WithAutoAPIVersion), the body is sent as an ADF
+// document, the comment Document or, if it is nil, the Body converted by TextToADF.
+// Otherwise, the Body is sent as wiki markup.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/comment
+func (i *IssuesService) AddComment(ctx context.Context, idOrKey string, comment *IssueComment) (*IssueComment, *Response, error) {
+	if comment == nil {
+		return nil, nil, ErrNilComment
+	}
+
+	if err := i.client.resolveAPIVersion(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	body := &newIssueComment{
+		Body:       comment.Body,
+		Visibility: comment.Visibility,
+	}
+	if i.client.version() == "3" {
+		if comment.Document != nil {
+			body.Body = comment.Document
+		} else {
+			body.Body = TextToADF(comment.Body)
+		}
+	}
+
+	req, err := i.client.NewRequest("POST", i.client.apiPath(fmt.Sprintf("issue/%s/comment", idOrKey)), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created = &IssueComment{}
+	resp, err := i.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// ListComments returns all comments of an issue, for a given issue Id or issue key.
+// This only includes comments that the user has permission to view.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/comment
+func (i *IssuesService) ListComments(ctx context.Context, idOrKey string, opts *CommentsOptions) ([]*IssueComment, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := i.client.NewRequest("GET", i.client.apiPath(fmt.Sprintf("issue/%s/comment%s", idOrKey, q)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &IssueCommentWrap{}
+	resp, err := i.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.MaxResults = wrap.MaxResults
+	resp.StartAt = wrap.StartAt
+	resp.IsLast = wrap.StartAt+len(wrap.Comments) >= wrap.Total
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Comments)
+
+	return wrap.Comments, resp, nil
+}
+
+// GetChangelog returns the change history of an issue, a page at a time, for a given issue Id or
+// issue key, oldest changes first. Where the dedicated endpoint is absent, as on Jira Server and
+// Data Center, the changelog is expanded on the issue instead and the page is cut from it.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/changelog
+func (i *IssuesService) GetChangelog(ctx context.Context, idOrKey string, opts *ChangelogOptions) ([]*IssueChangelog, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := i.client.NewRequest("GET", i.client.apiPath(fmt.Sprintf("issue/%s/changelog%s", idOrKey, q)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &IssueChangelogWrap{}
+	resp, err := i.client.Do(ctx, req, wrap)
+	if err != nil {
+		if errResp, ok := err.(*ErrorResponse); ok && errResp.StatusCode == http.StatusNotFound {
+			return i.expandedChangelog(ctx, idOrKey, opts)
+		}
+		return nil, resp, err
+	}
+
+	resp.MaxResults = wrap.MaxResults
+	resp.StartAt = wrap.StartAt
+	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
+
+	return wrap.Values, resp, nil
+}
+
+// expandedChangelog returns a page of the changelog expanded on the issue, for GetChangelog
+//
+// GET /rest/api/2/issue/{issueIdOrKey}?expand=changelog
+func (i *IssuesService) expandedChangelog(ctx context.Context, idOrKey string, opts *ChangelogOptions) ([]*IssueChangelog, *Response, error) {
+	issue, resp, err := i.GetIssue(ctx, idOrKey, &PlatformIssueOptions{Fields: []string{"created"}, Expand: []string{"changelog"}})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var histories []*IssueChangelog
+	if issue.Changelog != nil {
+		histories = issue.Changelog.Histories
+	}
+
+	startAt, maxResults := 0, len(histories)
+	if opts != nil {
+		startAt = opts.StartAt
+		if opts.MaxResults > 0 {
+			maxResults = opts.MaxResults
+		}
+	}
+	if startAt > len(histories) {
+		startAt = len(histories)
+	}
+	end := startAt + maxResults
+	if end > len(histories) {
+		end = len(histories)
+	}
+	page := histories[startAt:end]
+
+	resp.MaxResults = maxResults
+	resp.StartAt = startAt
+	resp.IsLast = end == len(histories)
+	resp.Total = len(histories)
+	resp.pageLen = len(page)
+
+	return page, resp, nil
+}
+
+// Transitions returns the transitions available for the issue in its current status,
+// for a given issue Id or issue key. This only includes the transitions the user has
+// permission to perform.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/transitions
+func (i *IssuesService) Transitions(ctx context.Context, idOrKey string) ([]*IssueTransition, *Response, error) {
+	req, err := i.client.NewRequest("GET", i.client.apiPath(fmt.Sprintf("issue/%s/transitions", idOrKey)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &IssueTransitionWrap{}
+	resp, err := i.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wrap.Transitions, resp, nil
+}
+
+// DoTransition performs a transition of the issue, for a given issue Id or issue key. The
+// fields of the transition screen can be updated by fields, e.g. the resolution:
+// map[string]interface{}{"resolution": map[string]string{"name": "Done"}}. ErrEmptyTransitionID
+// is returned without calling the API when the transition id is empty. The rich text fields
+// are sent as by Create.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/transitions
+func (i *IssuesService) DoTransition(ctx context.Context, idOrKey string, transitionID string, fields map[string]interface{}) (*Response, error) {
+	if transitionID == "" {
+		return nil, ErrEmptyTransitionID
+	}
+	if err := i.client.resolveAPIVersion(ctx); err != nil {
+		return nil, err
+	}
+
+	body := &issueTransitionRequest{
+		Transition: &IssueTransition{ID: transitionID},
+		Fields:     i.client.adfFields(fields),
+	}
+
+	req, err := i.client.NewRequest("POST", i.client.apiPath(fmt.Sprintf("issue/%s/transitions", idOrKey)), body)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.client.Do(ctx, req, nil)
+}
+
+// GetIssue returns a single issue from the Jira platform API, for a given issue Id or issue key.
+// Unlike Get, the returned issue does not include the Agile fields, but the issue properties
+// can be selected.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}
+func (i *IssuesService) GetIssue(ctx context.Context, idOrKey string, opts *PlatformIssueOptions) (*Issue, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := i.client.NewRequest("GET", i.client.apiPath(fmt.Sprintf("issue/%s%s", idOrKey, q)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var issue = &Issue{}
+	resp, err := i.client.Do(ctx, req, issue)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return issue, resp, nil
+}
+
+// getManyMaxKeys bounds the keys searched at once by GetMany, the search being sent by
+// SearchPost when its JQL is longer than getManyMaxJQL, to keep the URLs short
+const (
+	getManyMaxKeys = 100
+	getManyMaxJQL  = 2000
+)
+
+// GetMany returns the issues of the given keys, or ids, searching them by a key in (...) JQL
+// rather than fetching them one by one. The keys are split into several searches, sent in the
+// body of a POST request when their JQL is long, and each search is paginated. Only the given fields are returned, all the
+// navigable ones if empty. The issues are returned once, in the order of the keys; an issue
+// found by a former key, e.g. moved to another project, comes after them. The unknown keys and
+// the issues the user can not view are ignored. The returned response is the one of the last
+// page fetched.
+//
+// GET /rest/api/2/search
+func (i *IssuesService) GetMany(ctx context.Context, keys []string, fields []string) ([]*Issue, *Response, error) {
+	var (
+		found []*Issue
+		resp  *Response
+		err   error
+	)
+	for _, jql := range keysJQL(keys) {
+		var issues []*Issue
+		if len(jql) > getManyMaxJQL {
+			issues, resp, err = i.client.Search.SearchPostAll(ctx, &SearchRequest{JQL: jql, Fields: fields, ValidateQuery: "false"})
+		} else {
+			issues, resp, err = i.client.Search.SearchAll(ctx, jql, &SearchOptions{Fields: fields, ValidateQuery: "false"})
+		}
+		found = append(found, issues...)
+		if err != nil {
+			break
+		}
+	}
+
+	return orderByKeys(found, keys), resp, err
+}
+
+// keysJQL returns the key in (...) JQLs searching the given keys, each one holding at most
+// getManyMaxKeys keys
+func keysJQL(keys []string) []string {
+	var (
+		jqls  []string
+		chunk []string
+	)
+	seen := make(map[string]bool, len(keys))
+	flush := func() {
+		if len(chunk) > 0 {
+			jqls = append(jqls, "key in ("+strings.Join(chunk, ",")+")")
+			chunk = nil
+		}
+	}
+	for _, key := range keys {
+		key = strings.ToUpper(strings.TrimSpace(key))
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		quoted := `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(key) + `"`
+		if len(chunk) == getManyMaxKeys {
+			flush()
+		}
+		chunk = append(chunk, quoted)
+	}
+	flush()
+
+	return jqls
+}
+
+// orderByKeys returns the issues once, in the order of the given keys or ids, the issues
+// matching none of them being appended in the order found
+func orderByKeys(issues []*Issue, keys []string) []*Issue {
+	byKey := make(map[string]*Issue, len(issues))
+	for _, issue := range issues {
+		byKey[strings.ToUpper(issue.Key)] = issue
+		byKey[issue.ID] = issue
+	}
+
+	ordered := make([]*Issue, 0, len(issues))
+	added := make(map[*Issue]bool, len(issues))
+	add := func(issue *Issue) {
+		if issue != nil && !added[issue] {
+			added[issue] = true
+			ordered = append(ordered, issue)
+		}
+	}
+	for _, key := range keys {
+		add(byKey[strings.ToUpper(strings.TrimSpace(key))])
+	}
+	for _, issue := range issues {
+		if id := issue.ID; id != "" {
+			// the same issue may be returned by two searches, once per key
+			add(byKey[id])
+			continue
+		}
+		add(issue)
+	}
+
+	return ordered
+}
+
+// Create creates an issue or a sub-task from the given fields. The returned issue only
+// contains the id, the key and the self link of the created issue. When the version 3 of
+// the API is used, the description and the environment given as plain text are sent as
+// ADF documents, see TextToADF.
+//
+// POST /rest/api/2/issue
+func (i *IssuesService) Create(ctx context.Context, issue *IssueRequest) (*Issue, *Response, error) {
+	if err := i.client.resolveAPIVersion(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	req, err := i.client.NewRequest("POST", i.client.apiPath("issue"), i.client.adfIssueRequest(issue))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created = &Issue{}
+	resp, err := i.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// CreateBulk creates many issues or sub-tasks in a single request. The issues that could
+// not be created are reported in the Errors of the result, along with their index in issues.
+// The rich text fields are sent as by Create.
+//
+// POST /rest/api/2/issue/bulk
+func (i *IssuesService) CreateBulk(ctx context.Context, issues []*IssueRequest) (*BulkCreateResult, *Response, error) {
+	if err := i.client.resolveAPIVersion(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	requests := make([]*IssueRequest, len(issues))
+	for n, issue := range issues {
+		requests[n] = i.client.adfIssueRequest(issue)
+	}
+	body := map[string][]*IssueRequest{"issueUpdates": requests}
+
+	req, err := i.client.NewRequest("POST", i.client.apiPath("issue/bulk"), body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result = &BulkCreateResult{}
+	resp, err := i.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// Update edits an issue, for a given issue Id or issue key. The fields of the update are
+// overwritten and the operations applied, see IssueUpdate. The field-level errors of an
+// invalid update are available in the returned *JiraError. The rich text fields, overwritten
+// or set by an operation, are sent as by Create.
+//
+// PUT /rest/api/2/issue/{issueIdOrKey}
+func (i *IssuesService) Update(ctx context.Context, idOrKey string, update *IssueUpdate, opts *IssueUpdateOptions) (*Response, error) {
+	if err := i.client.resolveAPIVersion(ctx); err != nil {
+		return nil, err
+	}
+
+	q := QueryParameters(opts)
+
+	body := update
+	if update != nil {
+		body = &IssueUpdate{Fields: i.client.adfFields(update.Fields), Update: i.client.adfOperations(update.Update)}
+	}
+
+	req, err := i.client.NewRequest("PUT", i.client.apiPath(fmt.Sprintf("issue/%s%s", idOrKey, q)), body)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.client.Do(ctx, req, nil)
+}
+
+// Delete deletes an issue, for a given issue Id or issue key. An issue with sub-tasks can
+// only be deleted along with them, when deleteSubtasks is true. Otherwise, Jira returns
+// 400 Bad Request, available as a *JiraError.
+//
+// DELETE /rest/api/2/issue/{issueIdOrKey}
+func (i *IssuesService) Delete(ctx context.Context, idOrKey string, deleteSubtasks bool) (*Response, error) {
+	u := fmt.Sprintf("issue/%s", idOrKey)
+	if deleteSubtasks {
+		u += "?deleteSubtasks=true"
+	}
+
+	req, err := i.client.NewRequest("DELETE", i.client.apiPath(u), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.client.Do(ctx, req, nil)
+}
+
+// AssignIssue assigns an issue to a user, for a given issue Id or issue key and user
+// account id. The issue is unassigned when the account id is empty, and assigned to the
+// default assignee of the project when it is AssigneeAutomatic. An *AssignError, matching
+// ErrIssueOrUserNotFound, is returned when Jira responds 404 Not Found.
+//
+// PUT /rest/api/2/issue/{issueIdOrKey}/assignee
+func (i *IssuesService) AssignIssue(ctx context.Context, idOrKey string, accountID string) (*Response, error) {
+	assignee := map[string]*string{"accountId": nil}
+	if accountID != "" {
+		assignee["accountId"] = &accountID
+	}
+
+	req, err := i.client.NewRequest("PUT", i.client.apiPath(fmt.Sprintf("issue/%s/assignee", idOrKey)), assignee)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if errResp, ok := err.(*ErrorResponse); ok && errResp.StatusCode == http.StatusNotFound {
+		return resp, &AssignError{IssueIDOrKey: idOrKey, AccountID: accountID, Err: errResp}
+	}
+
+	return resp, err
+}
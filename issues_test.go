@@ -2,9 +2,13 @@ package jira
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -710,3 +714,523 @@ func TestIssuesServiceRankingWithError(t *testing.T) {
 	assert.Len(t, entries.Entries, 3)
 
 }
+
+func TestIssuesServiceAddComment(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, `{"body":"Looks good","visibility":{"type":"role","value":"Administrators"}}`+"\n", string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "10000","body": "Looks good","author": {"name": "fred"},"created": "2021-02-13T10:30:00.000+0000","visibility": {"type": "role","value": "Administrators"}}`)
+	})
+
+	comment := &IssueComment{
+		Body:       "Looks good",
+		Visibility: &IssueCommentVisibility{Type: "role", Value: "Administrators"},
+	}
+
+	created, _, err := client.Issues.AddComment(context.Background(), "MCP-1", comment)
+	assert.Nil(t, err)
+	assert.Equal(t, "10000", created.ID)
+	assert.Equal(t, "fred", created.Author.Name)
+	assert.Equal(t, "Administrators", created.Visibility.Value)
+}
+
+func TestIssuesServiceAddCommentNil(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Issues.AddComment(context.Background(), "MCP-1", nil)
+	assert.Equal(t, ErrNilComment, err)
+}
+
+func TestIssuesServiceListComments(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "1", r.URL.Query().Get("maxResults"))
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 1,"total": 2,"comments": [{"id": "10000","body": "Looks good"}]}`)
+	})
+
+	comments, resp, err := client.Issues.ListComments(context.Background(), "MCP-1", &CommentsOptions{MaxResults: 1})
+	assert.Nil(t, err)
+	assert.Len(t, comments, 1)
+	assert.Equal(t, "Looks good", comments[0].Body)
+	assert.Equal(t, 1, resp.MaxResults)
+	assert.False(t, resp.IsLast)
+}
+
+func TestIssuesServiceAddCommentADF(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithAPIVersion("3")(client)
+
+	mux.HandleFunc("/api/3/issue/MCP-1/comment", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"body":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"Looks good"}]}]}}`, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "10000","body": {"version": 1,"type": "doc","content": [{"type": "paragraph","content": [{"type": "text","text": "Looks good"}]}]}}`)
+	})
+
+	created, _, err := client.Issues.AddComment(context.Background(), "MCP-1", &IssueComment{Body: "Looks good"})
+	assert.Nil(t, err)
+	assert.Equal(t, "10000", created.ID)
+	assert.Equal(t, "Looks good", created.Document.Content[0].Content[0].Text)
+}
+
+func TestIssuesServiceTransitions(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/transitions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"expand": "transitions","transitions": [{"id": "2","name": "Close Issue","to": {"id": "6","name": "Closed"}},{"id": "711","name": "QA Review","to": {"id": "5","name": "In Review"}}]}`)
+	})
+
+	transitions, _, err := client.Issues.Transitions(context.Background(), "MCP-1")
+	assert.Nil(t, err)
+	assert.Len(t, transitions, 2)
+	assert.Equal(t, "Close Issue", transitions[0].Name)
+	assert.Equal(t, "Closed", transitions[0].To.Name)
+}
+
+func TestIssuesServiceDoTransition(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/transitions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"transition":{"id":"2"},"fields":{"resolution":{"name":"Done"}}}`, string(body))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	fields := map[string]interface{}{
+		"resolution": map[string]string{"name": "Done"},
+	}
+
+	resp, err := client.Issues.DoTransition(context.Background(), "MCP-1", "2", fields)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	_, err = client.Issues.DoTransition(context.Background(), "MCP-1", "", nil)
+	assert.Equal(t, ErrEmptyTransitionID, err)
+}
+
+func TestIssuesServiceGetIssue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "summary,status", r.URL.Query().Get("fields"))
+		assert.Equal(t, "names", r.URL.Query().Get("expand"))
+		_, ok := r.URL.Query()["properties"]
+		assert.False(t, ok)
+		fmt.Fprint(w, issueAsJSON)
+	})
+
+	opts := &PlatformIssueOptions{
+		Fields: []string{"summary", "status"},
+		Expand: []string{"names"},
+	}
+
+	issue, _, err := client.Issues.GetIssue(context.Background(), "MCP-1", opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "Project 1", issue.Fields.Project.Name)
+}
+
+func TestIssuesServiceGetIssueAllFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.URL.RawQuery)
+		fmt.Fprint(w, issueAsJSON)
+	})
+
+	_, _, err := client.Issues.GetIssue(context.Background(), "MCP-1", &PlatformIssueOptions{})
+	assert.Nil(t, err)
+}
+
+func TestIssuesServiceCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"fields":{"project":{"key":"MCP"},"issuetype":{"name":"Bug"},"summary":"Broken build","assignee":{"accountId":"5b10a2844c20165700ede21g"},"labels":["ci"]}}`, string(body))
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "10000","key": "MCP-24","self": "https://jira.mycompany.com/rest/api/2/issue/10000"}`)
+	})
+
+	issueReq := NewIssueRequest("MCP", "Bug", "Broken build").
+		SetAssignee("5b10a2844c20165700ede21g").
+		SetField("labels", []string{"ci"})
+
+	issue, _, err := client.Issues.Create(context.Background(), issueReq)
+	assert.Nil(t, err)
+	assert.Equal(t, "10000", issue.ID)
+	assert.Equal(t, "MCP-24", issue.Key)
+}
+
+func TestIssuesServiceCreateBulk(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/bulk", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		var body map[string][]*IssueRequest
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Len(t, body["issueUpdates"], 2)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"issues": [{"id": "10000","key": "MCP-24"}],
+		"errors": [{"status": 400,"failedElementNumber": 1,"elementErrors": {"errors": {"issuetype": "The issue type selected is invalid."}}}]}`)
+	})
+
+	issues := []*IssueRequest{
+		NewIssueRequest("MCP", "Bug", "Broken build"),
+		NewIssueRequest("MCP", "Unknown", "Broken deploy"),
+	}
+
+	result, _, err := client.Issues.CreateBulk(context.Background(), issues)
+	assert.Nil(t, err)
+	assert.Len(t, result.Issues, 1)
+	assert.Equal(t, "MCP-24", result.Issues[0].Key)
+	assert.Len(t, result.Errors, 1)
+	assert.Equal(t, 1, result.Errors[0].FailedElementNumber)
+	assert.Equal(t, "The issue type selected is invalid.", result.Errors[0].ElementErrors.Errors["issuetype"])
+}
+
+func TestIssuesServiceUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "false", r.URL.Query().Get("notifyUsers"))
+
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"fields":{"summary":"New summary"},"update":{"labels":[{"add":"triaged"},{"remove":"new"}]}}`, string(body))
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	update := (&IssueUpdate{}).
+		SetField("summary", "New summary").
+		AddOperation("labels", "add", "triaged").
+		AddOperation("labels", "remove", "new")
+
+	resp, err := client.Issues.Update(context.Background(), "MCP-1", update, &IssueUpdateOptions{NotifyUsers: Bool(false)})
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestIssuesServiceUpdateFieldErrors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages": [],"errors": {"summary": "Field 'summary' cannot be set."}}`)
+	})
+
+	_, err := client.Issues.Update(context.Background(), "MCP-1", (&IssueUpdate{}).SetField("summary", ""), nil)
+
+	jiraErr, ok := err.(*JiraError)
+	assert.True(t, ok)
+	assert.Equal(t, "Field 'summary' cannot be set.", jiraErr.Errors["summary"])
+}
+
+func TestIssuesServiceDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		if r.URL.Query().Get("deleteSubtasks") != "true" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"errorMessages": ["The issue has subtasks."]}`)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	_, err := client.Issues.Delete(context.Background(), "MCP-1", false)
+	jiraErr, ok := err.(*JiraError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusBadRequest, jiraErr.StatusCode)
+	assert.Equal(t, []string{"The issue has subtasks."}, jiraErr.Messages)
+
+	resp, err := client.Issues.Delete(context.Background(), "MCP-1", true)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestIssuesServiceAssignIssue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var bodies []string
+	mux.HandleFunc("/api/2/issue/MCP-1/assignee", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	for _, accountID := range []string{"5b10ac8d82e05b22cc7d4ef5", "", AssigneeAutomatic} {
+		resp, err := client.Issues.AssignIssue(context.Background(), "MCP-1", accountID)
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	}
+
+	assert.Equal(t, []string{
+		`{"accountId":"5b10ac8d82e05b22cc7d4ef5"}` + "\n",
+		`{"accountId":null}` + "\n",
+		`{"accountId":"-1"}` + "\n",
+	}, bodies)
+}
+
+func TestIssuesServiceAssignIssueNotFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/assignee", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages":["Issue does not exist or you do not have permission to see it."]}`)
+	})
+
+	resp, err := client.Issues.AssignIssue(context.Background(), "MCP-1", "5b10ac8d82e05b22cc7d4ef5")
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	assignErr, ok := err.(*AssignError)
+	if assert.True(t, ok) {
+		assert.True(t, assignErr.Is(ErrIssueOrUserNotFound))
+		assert.Equal(t, "MCP-1", assignErr.IssueIDOrKey)
+		assert.Equal(t, "5b10ac8d82e05b22cc7d4ef5", assignErr.AccountID)
+		assert.Equal(t, assignErr.Err, assignErr.Unwrap())
+		assert.Equal(t, []string{"Issue does not exist or you do not have permission to see it."}, assignErr.Err.Messages)
+		assert.Contains(t, assignErr.Error(), "issue or user not found")
+	}
+}
+
+func TestDateTimeUnmarshalJSON(t *testing.T) {
+	want := time.Date(2020, 3, 10, 9, 30, 0, 0, time.FixedZone("", 10*60*60))
+
+	for _, s := range []string{
+		`"2020-03-10T09:30:00.000+1000"`,
+		`"2020-03-10T09:30:00+1000"`,
+		`"2020-03-10T09:30:00.000+10:00"`,
+		`"2020-03-09T23:30:00.000Z"`,
+		`"2020-03-09T23:30:00Z"`,
+	} {
+		var d DateTime
+		assert.Nil(t, json.Unmarshal([]byte(s), &d), s)
+		assert.True(t, want.Equal(d.Time()), s)
+	}
+
+	var d DateTime
+	assert.Nil(t, json.Unmarshal([]byte(`null`), &d))
+	assert.True(t, d.Time().IsZero())
+
+	assert.NotNil(t, json.Unmarshal([]byte(`"10/03/2020"`), &d))
+}
+
+func TestDateTimeMarshalJSONMilliseconds(t *testing.T) {
+	d := DateTime(time.Date(2020, 3, 10, 9, 30, 0, 123456789, time.FixedZone("", -3*60*60)))
+	b, err := json.Marshal(d)
+	assert.Nil(t, err)
+	assert.Equal(t, `"2020-03-10T09:30:00.123-0300"`, string(b))
+}
+
+func TestQueryParametersTime(t *testing.T) {
+	type MyOptions struct {
+		After  DateTime  `query:"after"`
+		Before time.Time `query:"before"`
+	}
+
+	at := time.Date(2020, 3, 10, 9, 30, 0, 0, time.FixedZone("", 10*60*60))
+	assert.Equal(t, "?after=2020-03-10T09%3A30%3A00.000%2B1000", QueryParameters(&MyOptions{After: DateTime(at)}))
+	assert.Equal(t, "?before=2020-03-10T09%3A30%3A00.000%2B1000", QueryParameters(&MyOptions{Before: at}))
+	assert.Equal(t, "", QueryParameters(&MyOptions{}))
+}
+
+func TestIssuesServiceGetChangelog(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/changelog", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "2", r.URL.Query().Get("maxResults"))
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 2,"total": 3,"isLast": false,"values": [
+			{"id": "10001","author": {"accountId": "5b10a2844c20165700ede21g"},"created": "2020-07-06T10:01:00.000+0000","items": [{"field": "status","fieldtype": "jira","from": "1","fromString": "To Do","to": "3","toString": "In Progress"}]},
+			{"id": "10002","created": "2020-07-07T10:01:00.000+0000","items": [{"field": "summary","fromString": "Old","toString": "New"}]}
+		]}`)
+	})
+
+	changes, resp, err := client.Issues.GetChangelog(context.Background(), "MCP-1", &ChangelogOptions{MaxResults: 2})
+	assert.Nil(t, err)
+	assert.Len(t, changes, 2)
+	assert.Equal(t, "5b10a2844c20165700ede21g", changes[0].Author.AccountID)
+	assert.Equal(t, 2020, changes[0].Created.Time().Year())
+	assert.Equal(t, "status", changes[0].Items[0].Field)
+	assert.Equal(t, "To Do", changes[0].Items[0].FromString)
+	assert.Equal(t, "In Progress", changes[0].Items[0].ToString)
+	assert.True(t, resp.HasMore())
+	assert.Equal(t, 2, resp.NextStartAt())
+}
+
+func TestIssuesServiceGetChangelogExpandedFallback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/changelog", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/api/2/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "changelog", r.URL.Query().Get("expand"))
+		fmt.Fprint(w, `{"id": "10000","key": "MCP-1","changelog": {"startAt": 0,"maxResults": 3,"total": 3,"histories": [
+			{"id": "10001","items": [{"field": "status"}]},
+			{"id": "10002","items": [{"field": "summary"}]},
+			{"id": "10003","items": [{"field": "assignee"}]}
+		]}}`)
+	})
+
+	changes, resp, err := client.Issues.GetChangelog(context.Background(), "MCP-1", &ChangelogOptions{StartAt: 1, MaxResults: 1})
+	assert.Nil(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "10002", changes[0].ID)
+	assert.Equal(t, 1, resp.StartAt)
+	assert.True(t, resp.HasMore())
+
+	changes, resp, err = client.Issues.GetChangelog(context.Background(), "MCP-1", &ChangelogOptions{StartAt: 2})
+	assert.Nil(t, err)
+	assert.Len(t, changes, 1)
+	assert.Equal(t, "10003", changes[0].ID)
+	assert.True(t, resp.IsLast)
+}
+
+func TestIssuesServiceGetChangelogIssueNotFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-404/changelog", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/api/2/issue/MCP-404", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages": ["Issue does not exist or you do not have permission to see it."]}`)
+	})
+
+	_, resp, err := client.Issues.GetChangelog(context.Background(), "MCP-404", nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestIssuesServiceGetMany(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, `key in ("MCP-3","MCP-1","MCP-9")`, r.URL.Query().Get("jql"))
+		assert.Equal(t, "summary,status", r.URL.Query().Get("fields"))
+		assert.Equal(t, "false", r.URL.Query().Get("validateQuery"))
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 50,"total": 2,"issues": [{"id": "10001","key": "MCP-1"},{"id": "10003","key": "MCP-3"}]}`)
+	})
+
+	issues, _, err := client.Issues.GetMany(context.Background(), []string{"MCP-3", "MCP-1", "mcp-3", "MCP-9"}, []string{"summary", "status"})
+	assert.Nil(t, err)
+	assert.Len(t, issues, 2)
+	assert.Equal(t, "MCP-3", issues[0].Key)
+	assert.Equal(t, "MCP-1", issues[1].Key)
+}
+
+func TestIssuesServiceGetManyChunks(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var keys []string
+	for n := 1; n <= 150; n++ {
+		keys = append(keys, fmt.Sprintf("MCP-%d", n))
+	}
+
+	searches := 0
+	mux.HandleFunc("/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		searches++
+		jql := r.URL.Query().Get("jql")
+		assert.True(t, len(jql) <= getManyMaxJQL+len("key in ()"))
+
+		var found []string
+		for _, key := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(jql, "key in ("), ")"), ",") {
+			key = strings.Trim(key, `"`)
+			found = append(found, fmt.Sprintf(`{"id": "%s","key": "%s"}`, strings.TrimPrefix(key, "MCP-"), key))
+		}
+		fmt.Fprintf(w, `{"startAt": 0,"maxResults": 200,"total": %d,"issues": [%s]}`, len(found), strings.Join(found, ","))
+	})
+
+	issues, _, err := client.Issues.GetMany(context.Background(), keys, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, searches)
+	assert.Len(t, issues, 150)
+	assert.Equal(t, "MCP-1", issues[0].Key)
+	assert.Equal(t, "MCP-150", issues[149].Key)
+}
+
+func TestKeysJQL(t *testing.T) {
+	assert.Nil(t, keysJQL(nil))
+	assert.Equal(t, []string{`key in ("MCP-1","10002")`}, keysJQL([]string{" mcp-1 ", "", "10002", "MCP-1"}))
+	assert.Equal(t, []string{`key in ("A\"B")`}, keysJQL([]string{`a"b`}))
+}
+
+func TestOrderByKeys(t *testing.T) {
+	issues := []*Issue{{ID: "1", Key: "MCP-1"}, {ID: "2", Key: "NEW-2"}, {ID: "3", Key: "MCP-3"}, {ID: "1", Key: "MCP-1"}}
+
+	ordered := orderByKeys(issues, []string{"MCP-3", "MCP-2", "1"})
+	assert.Len(t, ordered, 3)
+	assert.Equal(t, "MCP-3", ordered[0].Key)
+	assert.Equal(t, "MCP-1", ordered[1].Key)
+	assert.Equal(t, "NEW-2", ordered[2].Key)
+}
+
+func TestIssuesServiceGetManyLongJQL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var keys []string
+	for n := 1; n <= 100; n++ {
+		keys = append(keys, fmt.Sprintf("VERYLONGPROJECTKEY-%d", n))
+	}
+
+	mux.HandleFunc("/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		var search SearchRequest
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&search))
+		assert.True(t, len(search.JQL) > getManyMaxJQL)
+		assert.Equal(t, "false", search.ValidateQuery)
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 50,"total": 2,"issues": [{"id": "2","key": "VERYLONGPROJECTKEY-2"},{"id": "1","key": "VERYLONGPROJECTKEY-1"}]}`)
+	})
+
+	issues, _, err := client.Issues.GetMany(context.Background(), keys, nil)
+	assert.Nil(t, err)
+	assert.Len(t, issues, 2)
+	assert.Equal(t, "VERYLONGPROJECTKEY-1", issues[0].Key)
+}
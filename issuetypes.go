@@ -0,0 +1,82 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrIssueTypeNotFound is returned by IssueTypesService.FindByName when no issue type has the given name
+var ErrIssueTypeNotFound = errors.New("jira: issue type not found")
+
+// IssueTypesService handles communication with the issue type related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/issuetype
+type IssueTypesService service
+
+// List returns all issue types visible to the user.
+//
+// GET /rest/api/2/issuetype
+func (i *IssueTypesService) List(ctx context.Context) ([]*IssueType, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", i.client.apiPath("issuetype"), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var issueTypes []*IssueType
+	resp, err := i.client.Do(ctx, req, &issueTypes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return issueTypes, resp, nil
+}
+
+// Get returns an issue type, for a given issue type Id.
+//
+// GET /rest/api/2/issuetype/{id}
+func (i *IssueTypesService) Get(ctx context.Context, id string) (*IssueType, *Response, error) {
+
+	req, err := i.client.NewRequest("GET", i.client.apiPath(fmt.Sprintf("issuetype/%s", id)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var issueType = &IssueType{}
+	resp, err := i.client.Do(ctx, req, issueType)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return issueType, resp, nil
+}
+
+// FindByName returns the issue type whose name matches the given one, ignoring case, e.g.
+// "Epic" to build the fields of EpicsService.Create. The issue types are cached like the
+// fields of FieldsService.FindByName (see WithFieldCache).
+// ErrIssueTypeNotFound is returned when there is no such issue type.
+func (i *IssueTypesService) FindByName(ctx context.Context, name string) (*IssueType, error) {
+	v, err := i.client.issueTypes.get(ctx, func(ctx context.Context) (interface{}, error) {
+		issueTypes, _, err := i.List(ctx)
+		return issueTypes, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, issueType := range v.([]*IssueType) {
+		if strings.EqualFold(issueType.Name, name) {
+			return issueType, nil
+		}
+	}
+
+	return nil, ErrIssueTypeNotFound
+}
+
+// InvalidateCache discards the cached issue types, so the next FindByName lists them again.
+func (i *IssueTypesService) InvalidateCache() {
+	i.client.issueTypes.invalidate()
+}
@@ -0,0 +1,98 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var issueTypesAsJSON = `[
+	{"id": "10000","name": "Epic","description": "A big user story that needs to be broken down.","iconUrl": "https://jira.mycompany.com/images/icons/issuetypes/epic.svg","subtask": false},
+	{"id": "10003","name": "Sub-task","description": "A small piece of work that's part of a larger task.","subtask": true}
+]`
+
+func TestIssueTypesServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issuetype", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, issueTypesAsJSON)
+	})
+
+	issueTypes, _, err := client.IssueTypes.List(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, issueTypes, 2)
+	assert.Equal(t, "Epic", issueTypes[0].Name)
+	assert.Equal(t, "https://jira.mycompany.com/images/icons/issuetypes/epic.svg", issueTypes[0].IconURL)
+	assert.False(t, issueTypes[0].SubTask)
+	assert.True(t, issueTypes[1].SubTask)
+}
+
+func TestIssueTypesServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issuetype/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": "10000","name": "Epic","description": "A big user story that needs to be broken down."}`)
+	})
+
+	issueType, _, err := client.IssueTypes.Get(context.Background(), "10000")
+	assert.Nil(t, err)
+	assert.Equal(t, "10000", issueType.ID)
+	assert.Equal(t, "A big user story that needs to be broken down.", issueType.Description)
+}
+
+func TestIssueTypesServiceFindByName(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/api/2/issuetype", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, issueTypesAsJSON)
+	})
+
+	issueType, err := client.IssueTypes.FindByName(context.Background(), "epic")
+	assert.Nil(t, err)
+	assert.Equal(t, "10000", issueType.ID)
+
+	issueType, err = client.IssueTypes.FindByName(context.Background(), "SUB-TASK")
+	assert.Nil(t, err)
+	assert.Equal(t, "10003", issueType.ID)
+
+	_, err = client.IssueTypes.FindByName(context.Background(), "Bug")
+	assert.Equal(t, ErrIssueTypeNotFound, err)
+
+	assert.Equal(t, 1, calls)
+}
+
+func TestIssueTypesServiceFindByNameCacheExpires(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/api/2/issuetype", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, issueTypesAsJSON)
+	})
+
+	WithFieldCache(10 * time.Millisecond)(client)
+
+	client.IssueTypes.FindByName(context.Background(), "Epic")
+	client.IssueTypes.FindByName(context.Background(), "Epic")
+	assert.Equal(t, 1, calls)
+
+	time.Sleep(20 * time.Millisecond)
+	client.IssueTypes.FindByName(context.Background(), "Epic")
+	assert.Equal(t, 2, calls)
+
+	client.IssueTypes.InvalidateCache()
+	client.IssueTypes.FindByName(context.Background(), "Epic")
+	assert.Equal(t, 3, calls)
+}
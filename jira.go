@@ -2,17 +2,42 @@ package jira
 
 import (
 	"bytes"
+	"compress/gzip"
+	"compress/zlib"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/fatih/structs"
+	"golang.org/x/oauth2"
+)
+
+const (
+	// libraryVersion is the version of this library
+	libraryVersion = "1.0.0"
+	// defaultUserAgent is the User-Agent sent by the client, unless defined by WithUserAgent
+	defaultUserAgent = "go-jira/" + libraryVersion
+)
+
+var (
+	// ErrNoMoreItems is returned by the iterators when all items have been read.
+	ErrNoMoreItems = errors.New("jira: no more items")
+	// ErrResponseTooLarge is returned when the body of a response exceeds the limit defined by WithMaxResponseBytes.
+	ErrResponseTooLarge = errors.New("jira: response body too large")
+	// ErrConflictingAuth is returned by NewClient when more than one authentication is configured.
+	ErrConflictingAuth = errors.New("jira: basic authentication, bearer token, token source and execute as are mutually exclusive")
 )
 
 // A Client manages communication with the Jira Agile API.
@@ -24,95 +49,462 @@ type Client struct {
 	// Reuse a single struct instead of allocating one for each service on the heap.
 	common service
 
-	Boards  *BoardsService
-	Epics   *EpicsService
-	Issues  *IssuesService
-	Sprints *SprintsService
-	Backlog *BacklogService
+	requestTimeout time.Duration
+	retry          retryPolicy
+
+	epicNameFieldID  string
+	apiVersion       string
+	apiVersionSet    bool
+	autoAPIVersion   bool
+	bearerToken      string
+	tokenSource      oauth2.TokenSource
+	logger           func(*http.Request, *http.Response, time.Duration)
+	observer         Observer
+	maxResponseBytes int64
+	defaultPageSize  int
+	pageConcurrency  int
+
+	retainResponseBody bool
+	cache              Cache
+	cacheKeys          cacheIndex
+	rateLimiter        RateLimiter
+	coalescing         *flightGroup
+	selfLinkHosts      []string
+	httpTrace          func(TraceInfo)
+	executeAs          string
+	connectApp         *connectApp
+	// impersonationTokenURL overrides the endpoint granting the tokens of WithExecuteAs
+	impersonationTokenURL string
+	userAgent             string
+
+	// ctx is the context of the client, canceled by Close, see Context
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// fields caches the fields returned by FieldsService.List for FieldsService.FindByName
+	fields listCache
+	// issueTypes caches the issue types returned by IssueTypesService.List for IssueTypesService.FindByName
+	issueTypes listCache
+
+	// apiVersionMu guards apiVersion when it is resolved by WithAutoAPIVersion,
+	// which resolveMu ensures is done once
+	apiVersionMu       sync.RWMutex
+	resolveMu          sync.Mutex
+	apiVersionResolved bool
+
+	// serverInfo caches the server information for IsCloud
+	serverInfoMu sync.Mutex
+	serverInfo   *ServerInfo
+
+	Boards          *BoardsService
+	Epics           *EpicsService
+	Issues          *IssuesService
+	Sprints         *SprintsService
+	Backlog         *BacklogService
+	Search          *SearchService
+	Worklogs        *WorklogsService
+	Attachments     *AttachmentsService
+	Watchers        *WatchersService
+	Votes           *VotesService
+	IssueLinks      *IssueLinksService
+	RemoteLinks     *RemoteLinksService
+	Projects        *ProjectsService
+	Users           *UsersService
+	Fields          *FieldsService
+	ServerInfo      *ServerInfoService
+	Labels          *LabelsService
+	Priorities      *PrioritiesService
+	Statuses        *StatusesService
+	Resolutions     *ResolutionsService
+	IssueTypes      *IssueTypesService
+	Components      *ComponentsService
+	Versions        *VersionsService
+	Filters         *FiltersService
+	Dashboards      *DashboardsService
+	Groups          *GroupsService
+	IssueProperties *IssuePropertiesService
+	BoardProperties *BoardPropertiesService
 }
 
 type service struct {
 	client *Client
 }
 
-// NewClient returns a new Jira Agile API client. If a nil httpClient is
-// provided, http.DefaultClient will be used. To use API methods which require
+var (
+	agilePathRegexp = regexp.MustCompile(`/agile/[0-9.]+$`)
+	apiPathRegexp   = regexp.MustCompile(`/rest/api/([0-9]+|latest)$`)
+)
+
+// normalizeBaseURL returns the URL of the Jira Agile API, ending by a slash, for the given URL
+// of a Jira instance. The URLs of the instance (https://jira.mycompany.com), of its REST API
+// (https://jira.mycompany.com/rest) and of the platform API (https://jira.mycompany.com/rest/api/2)
+// are resolved to https://jira.mycompany.com/rest/agile/1.0/, a context path being kept. The
+// URL must be absolute, using the http or https scheme.
+func normalizeBaseURL(baseURL string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(baseURL))
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" || u.Host == "" {
+		return nil, fmt.Errorf("jira: base URL %q must be absolute, e.g. https://jira.mycompany.com", baseURL)
+	}
+
+	path := strings.TrimRight(u.Path, "/")
+	switch {
+	case agilePathRegexp.MatchString(path):
+	case apiPathRegexp.MatchString(path):
+		path = apiPathRegexp.ReplaceAllString(path, "/rest/agile/1.0")
+	case strings.HasSuffix(path, "/rest"):
+		path += "/agile/1.0"
+	default:
+		path += "/rest/agile/1.0"
+	}
+	u.Path = path + "/"
+	u.RawPath = ""
+
+	return u, nil
+}
+
+// NewClient returns a new Jira Agile API client. baseURL is the URL of the Jira
+// instance, e.g. https://jira.mycompany.com, or of its Agile API, e.g.
+// https://jira.mycompany.com/rest/agile/1.0/, see normalizeBaseURL. If a nil httpClient is
+// provided, a copy of http.DefaultClient will be used. To use API methods which require
 // authentication, provide an http.Client that will perform the authentication
 // for you (such as that provided by the golang.org/x/oauth2 library).
-func NewClient(baseURL string, httpClient *http.Client) (*Client, error) {
+// Additional behaviors can be configured by the given options.
+func NewClient(baseURL string, httpClient *http.Client, opts ...ClientOption) (*Client, error) {
 	if httpClient == nil {
-		httpClient = http.DefaultClient
+		defaultClient := *http.DefaultClient
+		httpClient = &defaultClient
 	}
 
-	baseEndpoint, err := url.Parse(baseURL)
+	baseEndpoint, err := normalizeBaseURL(baseURL)
 	if err != nil {
 		return nil, err
 	}
 
-	if !strings.HasSuffix(baseEndpoint.Path, "/") {
-		baseEndpoint.Path += "/"
-	}
-
 	c := &Client{
-		client:  httpClient,
-		BaseURL: baseEndpoint,
+		client:     httpClient,
+		BaseURL:    baseEndpoint,
+		apiVersion: "2",
+		userAgent:  defaultUserAgent,
 	}
+	c.ctx, c.cancel = context.WithCancel(context.Background())
 	c.common.client = c
 	c.Boards = (*BoardsService)(&c.common)
 	c.Epics = (*EpicsService)(&c.common)
 	c.Issues = (*IssuesService)(&c.common)
 	c.Sprints = (*SprintsService)(&c.common)
 	c.Backlog = (*BacklogService)(&c.common)
+	c.Search = (*SearchService)(&c.common)
+	c.Worklogs = (*WorklogsService)(&c.common)
+	c.Attachments = (*AttachmentsService)(&c.common)
+	c.Watchers = (*WatchersService)(&c.common)
+	c.Votes = (*VotesService)(&c.common)
+	c.IssueLinks = (*IssueLinksService)(&c.common)
+	c.RemoteLinks = (*RemoteLinksService)(&c.common)
+	c.Projects = (*ProjectsService)(&c.common)
+	c.Users = (*UsersService)(&c.common)
+	c.Fields = (*FieldsService)(&c.common)
+	c.ServerInfo = (*ServerInfoService)(&c.common)
+	c.Labels = (*LabelsService)(&c.common)
+	c.Priorities = (*PrioritiesService)(&c.common)
+	c.Statuses = (*StatusesService)(&c.common)
+	c.Resolutions = (*ResolutionsService)(&c.common)
+	c.IssueTypes = (*IssueTypesService)(&c.common)
+	c.Components = (*ComponentsService)(&c.common)
+	c.Versions = (*VersionsService)(&c.common)
+	c.Filters = (*FiltersService)(&c.common)
+	c.Dashboards = (*DashboardsService)(&c.common)
+	c.Groups = (*GroupsService)(&c.common)
+	c.IssueProperties = (*IssuePropertiesService)(&c.common)
+	c.BoardProperties = (*BoardPropertiesService)(&c.common)
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, err
+		}
+	}
+
+	auths := 0
+	if _, ok := c.client.Transport.(*BasicAuthTransport); ok {
+		auths++
+	}
+	if c.bearerToken != "" {
+		auths++
+	}
+	if c.tokenSource != nil {
+		auths++
+	}
+	if c.executeAs != "" {
+		auths++
+	}
+	if auths > 1 {
+		return nil, ErrConflictingAuth
+	}
+
+	if c.executeAs != "" {
+		if c.connectApp == nil {
+			return nil, ErrNoConnectApp
+		}
+		c.tokenSource = c.impersonationTokenSource(c.client)
+	}
+
+	if c.tokenSource != nil {
+		hc := *c.client
+		hc.Transport = &tokenSourceTransport{
+			Source: oauth2.ReuseTokenSource(nil, c.tokenSource),
+			Base:   c.client.Transport,
+		}
+		c.client = &hc
+	}
 
 	return c, nil
 }
 
 // NewRequest creates an API request. A relative URL can be provided in urlStr,
-// in which case it is resolved relative to the BaseURL of the Client.
-// Relative URLs should always be specified without a preceding slash. If
+// in which case it is resolved relative to the BaseURL of the Client, even
+// if specified with a preceding slash. If
 // specified, the value pointed to by body is JSON encoded and included as the
 // request body.
 func (c *Client) NewRequest(method, urlStr string, body interface{}) (*http.Request, error) {
+	if body == nil {
+		return c.NewRequestRaw(method, urlStr, nil, "")
+	}
+
+	buf := new(bytes.Buffer)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(body); err != nil {
+		return nil, err
+	}
+
+	return c.NewRequestRaw(method, urlStr, buf, "application/json")
+}
+
+// NewRequestRaw creates an API request whose body is read from body as is, e.g. a
+// pre-serialized payload or a file, sent with the given Content-Type, if not empty.
+// A relative URL can be provided in urlStr, as in NewRequest. body is not closed by
+// the client. Requests with a body are retried only if the body can be read again,
+// i.e. body is a *bytes.Buffer, *bytes.Reader, *strings.Reader or an io.ReadSeeker,
+// rewound to its current offset, or GetBody is set on the returned request.
+func (c *Client) NewRequestRaw(method, urlStr string, body io.Reader, contentType string) (*http.Request, error) {
 	if !strings.HasSuffix(c.BaseURL.Path, "/") {
 		return nil, fmt.Errorf("BaseURL must have a trailing slash, but %q does not", c.BaseURL)
 	}
-	u, err := c.BaseURL.Parse(urlStr)
+	ref, err := url.Parse(urlStr)
+	if err != nil {
+		return nil, err
+	}
+	if !ref.IsAbs() && ref.Host == "" {
+		// a relative URL with a preceding slash is still resolved relative to the BaseURL
+		ref.Path = strings.TrimLeft(ref.Path, "/")
+	}
+	u := c.BaseURL.ResolveReference(ref)
+
+	req, err := http.NewRequest(method, u.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	var buf io.ReadWriter
 	if body != nil {
-		buf = new(bytes.Buffer)
-		enc := json.NewEncoder(buf)
-		enc.SetEscapeHTML(false)
-		err := enc.Encode(body)
-		if err != nil {
+		if _, ok := body.(io.Closer); ok {
+			req.Body = ioutil.NopCloser(body)
+		}
+		if s, ok := body.(io.Seeker); ok && req.GetBody == nil {
+			if err := setSeekableBody(req, body, s); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("Accept-Encoding", "gzip, deflate")
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	if c.bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.bearerToken)
+	}
+	return req, nil
+}
+
+// setSeekableBody sets the length of the body of req, read from r, and GetBody, which
+// rewinds r to its current offset so the request can be sent again.
+func setSeekableBody(req *http.Request, r io.Reader, s io.Seeker) error {
+	offset, err := s.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	end, err := s.Seek(0, io.SeekEnd)
+	if err != nil {
+		return err
+	}
+	if _, err := s.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+
+	req.ContentLength = end - offset
+	if req.ContentLength == 0 {
+		req.Body = http.NoBody
+	}
+	req.GetBody = func() (io.ReadCloser, error) {
+		if _, err := s.Seek(offset, io.SeekStart); err != nil {
 			return nil, err
 		}
+		return ioutil.NopCloser(r), nil
 	}
+	return nil
+}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+// newMultipartRequest creates an API request whose body is a multipart/form-data
+// form holding a single file, read from r, in the given field. A relative URL can
+// be provided in urlStr, as in NewRequest. The X-Atlassian-Token header is set
+// because Jira rejects multipart requests without it as XSRF attempts. The form is
+// streamed rather than buffered, the caller must close the body of the request once
+// done with it. The request can be sent again only if r is an io.ReadSeeker.
+func (c *Client) newMultipartRequest(method, urlStr, field, filename string, r io.Reader) (*http.Request, error) {
+	var offset int64
+	s, seekable := r.(io.Seeker)
+	if seekable {
+		var err error
+		if offset, err = s.Seek(0, io.SeekCurrent); err != nil {
+			return nil, err
+		}
+	}
+
+	boundary := multipart.NewWriter(nil).Boundary()
+	var (
+		body io.Closer
+		done chan struct{}
+	)
+	form := func() io.ReadCloser {
+		pr, pw := io.Pipe()
+		body, done = pr, make(chan struct{})
+		go func(done chan struct{}) {
+			defer close(done)
+			w := multipart.NewWriter(pw)
+			err := w.SetBoundary(boundary)
+			var part io.Writer
+			if err == nil {
+				part, err = w.CreateFormFile(field, filename)
+			}
+			if err == nil {
+				_, err = io.Copy(part, r)
+			}
+			if err == nil {
+				err = w.Close()
+			}
+			pw.CloseWithError(err)
+		}(done)
+		return pr
+	}
+
+	w := multipart.NewWriter(nil)
+	if err := w.SetBoundary(boundary); err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequestRaw(method, urlStr, nil, w.FormDataContentType())
 	if err != nil {
 		return nil, err
 	}
 
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	req.Body = form()
+	if seekable {
+		req.GetBody = func() (io.ReadCloser, error) {
+			// stops writing the previous form before reading r again
+			body.Close()
+			<-done
+			if _, err := s.Seek(offset, io.SeekStart); err != nil {
+				return nil, err
+			}
+			return form(), nil
+		}
 	}
+	req.Header.Set("X-Atlassian-Token", "no-check")
 
 	return req, nil
 }
 
+// apiPath returns the path of a Jira platform REST API resource, relative to
+// the BaseURL, which points to the Jira Agile API. For example, when BaseURL is
+// https://jira.mycompany.com/rest/agile/1.0/, apiPath("issue") refers to
+// https://jira.mycompany.com/rest/api/2/issue. The version can be changed by
+// WithAPIVersion or resolved by WithAutoAPIVersion.
+func (c *Client) apiPath(path string) string {
+	return "../../api/" + c.version() + "/" + path
+}
+
 // Do sends an API request and returns the API response. The API response is
 // JSON decoded and stored in the value pointed to by v, or returned as an
 // error if an API error has occurred. If v implements the io.Writer
 // interface, the raw response body will be written to v, without attempting to
-// first decode it.
-func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
-	req.WithContext(ctx)
+// first decode it. A 304 Not Modified response, to a request made conditional by
+// WithIfNoneMatch, is not an error: v is left untouched and Response.NotModified is set.
+//
+// If a request timeout was configured, ctx is wrapped by a context with that
+// timeout, a shorter deadline already defined by ctx still prevails. If a retry
+// policy was configured, failed attempts are retried as described by WithRetry.
+// If a rate limiter was configured, each attempt waits for it, see WithRateLimiter.
+func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (response *Response, err error) {
+	ctx = c.clientContext(ctx)
+	var attempts int
+	if c.observer != nil {
+		start := time.Now()
+		defer func() {
+			c.observe(ctx, req, response, err, attempts, time.Since(start))
+		}()
+	}
+
+	if req, err = c.withResolvedAPIVersion(ctx, req); err != nil {
+		return nil, err
+	}
 
-	resp, err := c.client.Do(req)
+	c.retry.budget.request()
+	for attempt := 1; ; attempt++ {
+		attempts = attempt
+		if err := c.waitRateLimit(ctx); err != nil {
+			return response, err
+		}
+
+		response, err = c.do(ctx, req, v)
+		if response != nil {
+			response.Attempts = attempt
+		}
+
+		if !c.retry.shouldRetry(ctx, req, attempt, response, err) || !c.retry.budget.withdraw() {
+			return response, err
+		}
+
+		if err := c.retry.wait(ctx, attempt, response); err != nil {
+			return response, err
+		}
+
+		if req, err = rewindBody(req); err != nil {
+			return response, err
+		}
+	}
+}
+
+// do sends the API request once, see Do.
+func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Response, error) {
+	if c.requestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+		defer cancel()
+	}
+	req = withContextHeaders(req.WithContext(ctx))
+	req, cached := c.conditionalRequest(req)
+
+	req, traced := c.trace(req)
+	start := time.Now()
+	resp, err := c.send(req)
+	traced(err)
+	c.log(req, resp, start)
 	if err != nil {
 		// If we got an error, and the context has been canceled,
 		// the context's error is probably more useful.
@@ -122,28 +514,54 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 		default:
 		}
 
+		if uerr, ok := err.(*url.Error); ok {
+			if tokenErr, ok := uerr.Err.(*TokenError); ok {
+				return nil, tokenErr
+			}
+			if impErr, ok := uerr.Err.(*ImpersonationError); ok {
+				return nil, impErr
+			}
+		}
+
 		return nil, err
 	}
-	defer resp.Body.Close()
+	defer drainBody(resp.Body)
 
-	response := &Response{
-		Response: resp,
+	if err := decompressBody(resp); err != nil {
+		return newResponse(resp), err
+	}
+	if c.maxResponseBytes > 0 {
+		resp.Body = &maxBytesReader{ReadCloser: resp.Body, n: c.maxResponseBytes}
 	}
 
-	if code := resp.StatusCode; code < 200 || code > 299 {
-		errResp := &ErrorResponse{
-			Response: resp,
+	response := newResponse(resp)
+
+	if c.retainResponseBody {
+		raw, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return response, err
 		}
-		data, err := ioutil.ReadAll(resp.Body)
-		if err == nil && data != nil {
-			json.Unmarshal(data, errResp)
+		response.RawBody = raw
+		resp.Body = ioutil.NopCloser(bytes.NewReader(raw))
+	}
+
+	if err := c.updateCache(req, resp, response, cached); err != nil {
+		return response, err
+	}
+
+	if response.NotModified {
+		return response, nil
+	}
+
+	if !response.FromCache {
+		if err := checkResponse(resp); err != nil {
+			return response, err
 		}
-		return response, errResp
 	}
 
 	if v != nil {
 		if w, ok := v.(io.Writer); ok {
-			io.Copy(w, resp.Body)
+			_, err = io.Copy(w, resp.Body)
 		} else {
 			decErr := json.NewDecoder(resp.Body).Decode(v)
 			if decErr == io.EOF {
@@ -158,11 +576,353 @@ func (c *Client) Do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	return response, err
 }
 
+// decompressBody replaces the body of the response by a reader that decompresses it,
+// according to its Content-Encoding. NewRequest asks for gzip or deflate compressed
+// responses, so http.Transport does not decompress them. Responses already
+// decompressed, e.g. by a custom transport, are left as is.
+func decompressBody(resp *http.Response) error {
+	if resp.Uncompressed {
+		return nil
+	}
+
+	var (
+		r   io.ReadCloser
+		err error
+	)
+	switch strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding"))) {
+	case "gzip":
+		r, err = gzip.NewReader(resp.Body)
+	case "deflate":
+		r, err = zlib.NewReader(resp.Body)
+	default:
+		return nil
+	}
+	if err == io.EOF {
+		// an empty body, e.g. 204 No Content
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	resp.Body = &decompressReadCloser{ReadCloser: r, body: resp.Body}
+	resp.Header.Del("Content-Encoding")
+	resp.Header.Del("Content-Length")
+	resp.ContentLength = -1
+	resp.Uncompressed = true
+	return nil
+}
+
+// decompressReadCloser closes both the decompressor and the body it reads from
+type decompressReadCloser struct {
+	io.ReadCloser
+	body io.ReadCloser
+}
+
+// Close closes the decompressor and the body
+func (r *decompressReadCloser) Close() error {
+	r.ReadCloser.Close()
+	return r.body.Close()
+}
+
+// maxBytesReader reads at most n bytes from the body of a response, and
+// fails with ErrResponseTooLarge if the body is longer, see WithMaxResponseBytes
+type maxBytesReader struct {
+	io.ReadCloser
+	n int64
+}
+
+// Read implements the io.Reader interface
+func (r *maxBytesReader) Read(p []byte) (int, error) {
+	if r.n <= 0 {
+		// the limit is reached, the body is too large unless it ends here
+		var b [1]byte
+		n, err := r.ReadCloser.Read(b[:])
+		if n > 0 {
+			return 0, ErrResponseTooLarge
+		}
+		return 0, err
+	}
+
+	if int64(len(p)) > r.n {
+		p = p[:r.n]
+	}
+	n, err := r.ReadCloser.Read(p)
+	r.n -= int64(n)
+	return n, err
+}
+
+// redactedHeaders are the request headers hidden from the logger, see WithLogger
+var redactedHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie"}
+
+// log calls the logger defined by WithLogger, if any, with copies of the request and
+// the response that hide the credentials and the body
+func (c *Client) log(req *http.Request, resp *http.Response, start time.Time) {
+	if c.logger == nil {
+		return
+	}
+	d := time.Since(start)
+
+	req2 := new(http.Request)
+	*req2 = *req
+	req2.Body = http.NoBody
+	req2.Header = make(http.Header, len(req.Header))
+	for k, s := range req.Header {
+		req2.Header[k] = append([]string(nil), s...)
+	}
+	for _, h := range redactedHeaders {
+		if req2.Header.Get(h) != "" {
+			req2.Header.Set(h, "REDACTED")
+		}
+	}
+
+	var resp2 *http.Response
+	if resp != nil {
+		resp2 = new(http.Response)
+		*resp2 = *resp
+		resp2.Body = http.NoBody
+		resp2.Request = req2
+	}
+
+	c.logger(req2, resp2, d)
+}
+
+// maxRedirects is the number of redirects followed by stream before giving up
+const maxRedirects = 10
+
+// stream sends an API request and returns the API response without reading its
+// body, which the caller must close. Unlike Do, failed requests are not retried.
+// Redirects are followed, but the credentials are only sent to the host of the
+// BaseURL, so they are not leaked when Jira redirects to a CDN.
+func (c *Client) stream(ctx context.Context, req *http.Request) (response *Response, err error) {
+	ctx = c.clientContext(ctx)
+	if c.observer != nil {
+		start := time.Now()
+		defer func(req *http.Request) {
+			c.observe(ctx, req, response, err, 1, time.Since(start))
+		}(req)
+	}
+
+	if req, err = c.withResolvedAPIVersion(ctx, req); err != nil {
+		return nil, err
+	}
+
+	if err = c.waitRateLimit(ctx); err != nil {
+		return nil, err
+	}
+
+	cancel := context.CancelFunc(func() {})
+	if c.requestTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+	}
+	req = withContextHeaders(req.WithContext(ctx))
+
+	hc := *c.client
+	hc.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	for redirects := 0; ; redirects++ {
+		traced, done := c.trace(req)
+		start := time.Now()
+		resp, err := hc.Do(traced)
+		done(err)
+		c.log(req, resp, start)
+		if err != nil {
+			cancel()
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			default:
+			}
+
+			if uerr, ok := err.(*url.Error); ok {
+				if tokenErr, ok := uerr.Err.(*TokenError); ok {
+					return nil, tokenErr
+				}
+				if impErr, ok := uerr.Err.(*ImpersonationError); ok {
+					return nil, impErr
+				}
+			}
+
+			return nil, err
+		}
+
+		switch resp.StatusCode {
+		case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther,
+			http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+			loc, err := resp.Location()
+			drainBody(resp.Body)
+			if err != nil {
+				cancel()
+				return newResponse(resp), err
+			}
+			if redirects >= maxRedirects {
+				cancel()
+				return newResponse(resp), fmt.Errorf("jira: stopped after %d redirects", maxRedirects)
+			}
+
+			next, err := http.NewRequest("GET", loc.String(), nil)
+			if err != nil {
+				cancel()
+				return newResponse(resp), err
+			}
+			next = next.WithContext(ctx)
+			if ua := req.Header.Get("User-Agent"); ua != "" {
+				next.Header.Set("User-Agent", ua)
+			}
+			if loc.Host == c.BaseURL.Host {
+				if auth := req.Header.Get("Authorization"); auth != "" {
+					next.Header.Set("Authorization", auth)
+				}
+			} else {
+				hc.Transport = unauthenticated(hc.Transport)
+			}
+			req = next
+			continue
+		}
+
+		if err := decompressBody(resp); err != nil {
+			resp.Body.Close()
+			cancel()
+			return newResponse(resp), err
+		}
+
+		if err := checkResponse(resp); err != nil {
+			resp.Body.Close()
+			cancel()
+			return newResponse(resp), err
+		}
+
+		resp.Body = &cancelReadCloser{ReadCloser: resp.Body, cancel: cancel}
+		return newResponse(resp), nil
+	}
+}
+
+// maxDrainBytes is the number of bytes of a body read by drainBody before closing it. The
+// connection of a larger body is closed rather than reused, which is cheaper than reading it.
+const maxDrainBytes = 1 << 20
+
+// drainBody reads the rest of body, up to maxDrainBytes, and closes it, so that http.Transport
+// can reuse its connection, which is closed when the body is closed before being fully read.
+func drainBody(body io.ReadCloser) error {
+	_, err := io.Copy(ioutil.Discard, io.LimitReader(body, maxDrainBytes))
+	if cerr := body.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// cancelReadCloser releases the context of a streamed response when its body is closed
+type cancelReadCloser struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the context
+func (r *cancelReadCloser) Close() error {
+	err := r.ReadCloser.Close()
+	r.cancel()
+	return err
+}
+
+// unauthenticated returns the transport wrapped by the authentication transports
+// of this package, so the requests it sends carry no credentials.
+func unauthenticated(rt http.RoundTripper) http.RoundTripper {
+	switch t := rt.(type) {
+	case *BasicAuthTransport:
+		return unauthenticated(t.Transport)
+	case *tokenSourceTransport:
+		return unauthenticated(t.Base)
+	case nil:
+		return http.DefaultTransport
+	}
+	return rt
+}
+
+// checkResponse returns an *ErrorResponse when the status code of the response
+// is not 2xx, holding the error messages read from its body.
+func checkResponse(resp *http.Response) error {
+	if code := resp.StatusCode; code >= 200 && code <= 299 {
+		return nil
+	}
+
+	errResp := &ErrorResponse{
+		Response:   resp,
+		StatusCode: resp.StatusCode,
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err == nil && data != nil {
+		errResp.Body = data
+		json.Unmarshal(data, errResp)
+	}
+	return errResp
+}
+
 // Pagination contains the information about pagination
 type Pagination struct {
 	MaxResults int  `json:"maxResults,omitempty"`
 	StartAt    int  `json:"startAt,omitempty"`
 	IsLast     bool `json:"isLast,omitempty"`
+	// Total is the number of items of all pages, or 0 if the endpoint does not return it,
+	// as some Agile API endpoints, see KnownTotal.
+	Total int `json:"total,omitempty"`
+}
+
+// KnownTotal reports whether the total number of items was returned. An empty total can
+// not be told from a missing one, so it is reported unknown, the pagination then relying
+// on IsLast.
+func (p Pagination) KnownTotal() bool {
+	return p.Total > 0
+}
+
+// Progress reports the progress of an auto-paginator, e.g. EpicsService.ListIssuesAll, after each
+// page: the number of items fetched so far and the total number of items, or -1 if unknown, see
+// Pagination.KnownTotal. It is called by the goroutine calling the auto-paginator, in the order
+// of the pages, even when they are fetched concurrently, see WithPageConcurrency.
+type Progress func(fetched, total int)
+
+// report calls the progress, if any, after a page
+func (p Progress) report(fetched int, resp *Response) {
+	if p == nil {
+		return
+	}
+	total := -1
+	if resp.KnownTotal() {
+		total = resp.Total
+	}
+	p(fetched, total)
+}
+
+// maxPrealloc bounds the capacity preallocated by the auto-paginators from the total
+const maxPrealloc = 10000
+
+// prealloc returns the capacity to preallocate for the items of all pages, once the first
+// page tells the total, bounded by maxPrealloc
+func (p Pagination) prealloc() int {
+	if p.Total > maxPrealloc {
+		return maxPrealloc
+	}
+	return p.Total
+}
+
+// pageSize returns the page size requested by the auto-paginators: maxResults when it is
+// set, the default page size of the client otherwise, see WithDefaultPageSize
+func (c *Client) pageSize(maxResults int) int {
+	if maxResults != 0 {
+		return maxResults
+	}
+	return c.defaultPageSize
+}
+
+// HasMore reports whether there are more pages after this one
+func (p Pagination) HasMore() bool {
+	return !p.IsLast
+}
+
+// NextStartAt returns the StartAt of the next page, assuming this page is full
+func (p Pagination) NextStartAt() int {
+	return p.StartAt + p.MaxResults
 }
 
 // Response is a Jira Agile API response. This wraps the standard http.Response
@@ -171,19 +931,135 @@ type Pagination struct {
 type Response struct {
 	*http.Response
 	Pagination
+
+	// Attempts is the number of attempts made to get this response.
+	Attempts int
+
+	// RateLimitRemaining is the number of requests remaining in the current
+	// rate limit window, as reported by the X-RateLimit-Remaining header.
+	RateLimitRemaining int
+	// RateLimitReset is the time when the current rate limit window resets,
+	// as reported by the X-RateLimit-Reset header.
+	RateLimitReset time.Time
+	// RetryAfter is how long the client should wait before sending a new
+	// request, as reported by the Retry-After header when throttled.
+	RetryAfter time.Duration
+
+	// Duplicates is the number of duplicated items dropped while paginating, see IssuesOptions.Dedup.
+	Duplicates int
+
+	// ETag is the entity tag of the returned resource, as reported by the ETag header. It can be
+	// given to WithIfNoneMatch to only get the resource again when it has changed.
+	ETag string
+	// NotModified reports whether the server answered 304 Not Modified to a request made
+	// conditional by WithIfNoneMatch, in which case nothing is decoded.
+	NotModified bool
+	// FromCache reports whether the body was taken from the cache defined by WithResponseCache,
+	// the server having answered 304 Not Modified.
+	FromCache bool
+
+	// Username is the name of the user the request was run as, as reported by the X-AUSERNAME
+	// header of Jira Data Center. It is anonymous when not logged in.
+	Username string
+
+	// RawBody is the body of the response as read from the server, before being decoded.
+	// It is only set when enabled by WithRetainResponseBody.
+	RawBody []byte
+
+	// pageLen is the number of items in the page, or -1 if the response is not paginated.
+	pageLen int
+}
+
+// Drain discards the rest of the body of the response and closes it, so that its connection
+// can be reused, e.g. when the reader returned by AttachmentsService.Download is not needed
+// anymore. The responses returned by the service methods are already drained. The body is
+// then replaced by http.NoBody, so Drain can be called more than once.
+func (r *Response) Drain() error {
+	if r == nil || r.Response == nil || r.Body == nil {
+		return nil
+	}
+	err := drainBody(r.Body)
+	r.Body = http.NoBody
+	return err
+}
+
+// HasMore reports whether there are more pages after the one of this response. Besides
+// IsLast, an empty page ends the pagination, since older Jira versions do not always set it.
+func (r *Response) HasMore() bool {
+	return !r.IsLast && r.pageLen != 0
 }
 
-// ErrorResponse reports one or more errors caused by an API request.
+// NextStartAt returns the StartAt of the page after the one of this response. It is based
+// on the number of items in the page, which can be lower than MaxResults, e.g. when Jira
+// limits the page size.
+func (r *Response) NextStartAt() int {
+	if r.pageLen < 0 {
+		return r.Pagination.NextStartAt()
+	}
+	return r.StartAt + r.pageLen
+}
+
+// newResponse creates a new Response for the provided http.Response,
+// populating the rate limit information from its headers.
+func newResponse(r *http.Response) *Response {
+	response := &Response{
+		Response:    r,
+		ETag:        r.Header.Get("ETag"),
+		Username:    r.Header.Get("X-AUSERNAME"),
+		NotModified: r.StatusCode == http.StatusNotModified,
+		pageLen:     -1,
+	}
+
+	if v := r.Header.Get("X-RateLimit-Remaining"); v != "" {
+		response.RateLimitRemaining, _ = strconv.Atoi(v)
+	}
+
+	if v := r.Header.Get("X-RateLimit-Reset"); v != "" {
+		if t, err := time.Parse(time.RFC3339, v); err == nil {
+			response.RateLimitReset = t
+		} else if t, err := time.Parse("2006-01-02T15:04Z07:00", v); err == nil {
+			response.RateLimitReset = t
+		} else if sec, err := strconv.ParseInt(v, 10, 64); err == nil {
+			response.RateLimitReset = time.Unix(sec, 0)
+		}
+	}
+
+	if v := r.Header.Get("Retry-After"); v != "" {
+		if sec, err := strconv.Atoi(v); err == nil {
+			response.RetryAfter = time.Duration(sec) * time.Second
+		} else if t, err := http.ParseTime(v); err == nil {
+			response.RetryAfter = time.Until(t)
+		}
+	}
+
+	return response
+}
+
+// ErrorResponse reports one or more errors caused by an API request. The general
+// messages and the field-level errors are parsed from the body returned by Jira,
+// callers can get them using errors.As(err, &jiraErr).
 type ErrorResponse struct {
-	Response *http.Response
-	Messages []string          `json:"errorMessages,omitempty"`
-	Errors   map[string]string `json:"errors,omitempty"`
+	Response   *http.Response
+	StatusCode int               `json:"-"`
+	Messages   []string          `json:"errorMessages,omitempty"`
+	Errors     map[string]string `json:"errors,omitempty"`
+	// Body is the raw body of the response, even when it is not valid JSON.
+	Body []byte `json:"-"`
 }
 
+// JiraError is an alias of ErrorResponse.
+type JiraError = ErrorResponse
+
 func (r *ErrorResponse) Error() string {
+	if len(r.Messages) == 0 && len(r.Errors) == 0 {
+		return fmt.Sprintf("%v %v: %d %v",
+			r.Response.Request.Method, r.Response.Request.URL,
+			r.StatusCode, http.StatusText(r.StatusCode))
+	}
+
 	return fmt.Sprintf("%v %v: %d %v %+v",
 		r.Response.Request.Method, r.Response.Request.URL,
-		r.Response.StatusCode, r.Messages, r.Errors)
+		r.StatusCode, r.Messages, r.Errors)
 }
 
 // BasicAuthTransport is an http.RoundTripper that authenticates all requests
@@ -227,6 +1103,15 @@ func (t *BasicAuthTransport) Client() *http.Client {
 // QueryParameters returns a query parameters string to use in the request.
 // Some endpoint allow options using query parameters, this method returns a
 // string as expected: ?k1=v1&k2=v2&k3=v3
+//
+// Zero values and fields without a query tag, or tagged `query:"-"`, are omitted. Pointer fields are only emitted when they are not
+// nil, so a *bool pointing to false is sent as k=false. Slices of strings, or of string types like SprintState, are
+// omitted when empty and, by default or with the comma tag option, joined by
+// commas: `query:"k,comma"` gives k=v1,v2. With the repeat tag option, the key is
+// repeated for each element instead: `query:"k,repeat"` gives k=v1&k=v2. Times,
+// either DateTime or time.Time, are formatted as 2006-01-02T15:04:05.000-0700. The
+// other values are sent as is, unless URL-encoded with the escape tag option, e.g.
+// `query:"jql,escape"` for a JQL query.
 func QueryParameters(val interface{}) string {
 	if val == nil || (reflect.ValueOf(val).Kind() == reflect.Ptr && reflect.ValueOf(val).IsNil()) {
 		return ""
@@ -235,13 +1120,47 @@ func QueryParameters(val interface{}) string {
 	var query []string
 
 	s := structs.New(val)
-	m := s.Map()
 
-	for k, v := range m {
-		f := s.Field(k)
-		t := f.Tag("query")
+	for _, f := range s.Fields() {
+		v := f.Value()
+		t, opt := f.Tag("query"), ""
+		if i := strings.Index(t, ","); i >= 0 {
+			t, opt = t[:i], t[i+1:]
+		}
+		if t == "" || t == "-" {
+			continue
+		}
 
 		if !f.IsZero() {
+			if rv := reflect.ValueOf(v); rv.Kind() == reflect.Ptr {
+				v = rv.Elem().Interface()
+			}
+			if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice && rv.Type().Elem().Kind() == reflect.String {
+				ss := make([]string, rv.Len())
+				for i := range ss {
+					ss[i] = rv.Index(i).String()
+				}
+				if len(ss) == 0 {
+					continue
+				}
+				if opt == "repeat" {
+					for _, e := range ss {
+						query = append(query, fmt.Sprintf("%v=%v", t, e))
+					}
+					continue
+				}
+				v = strings.Join(ss, ",")
+			}
+			switch tv := v.(type) {
+			case DateTime:
+				v = url.QueryEscape(time.Time(tv).Format(dateTimeLayout))
+			case time.Time:
+				v = url.QueryEscape(tv.Format(dateTimeLayout))
+			default:
+				if opt == "escape" {
+					v = url.QueryEscape(fmt.Sprint(v))
+				}
+			}
 			query = append(query, fmt.Sprintf("%v=%v", t, v))
 		}
 	}
@@ -252,3 +1171,82 @@ func QueryParameters(val interface{}) string {
 
 	return "?" + strings.Join(query, "&")
 }
+
+// requestHeadersKey is the context key of the headers defined by WithRequestHeaders
+type requestHeadersKey struct{}
+
+// WithIfNoneMatch returns a copy of ctx making the GET requests sent with it conditional on the
+// given entity tag, usually the Response.ETag of a previous call: when the resource has not
+// changed, the server answers 304 Not Modified, which is reported by Response.NotModified
+// instead of an error and without any payload.
+func WithIfNoneMatch(ctx context.Context, etag string) context.Context {
+	return WithRequestHeaders(ctx, http.Header{"If-None-Match": {etag}})
+}
+
+// protectedHeaders are the headers set by the client that WithRequestHeaders cannot override
+var protectedHeaders = []string{"Authorization", "Content-Type", "Content-Length", "Accept-Encoding", "X-Atlassian-Token"}
+
+// WithRequestHeaders returns a copy of ctx carrying headers to add to the requests sent
+// with it, e.g. a correlation id for tracing, without changing the methods signatures.
+// The headers set by the client, like Authorization and Content-Type, are not overridden.
+func WithRequestHeaders(ctx context.Context, h http.Header) context.Context {
+	if prev, ok := ctx.Value(requestHeadersKey{}).(http.Header); ok {
+		merged := make(http.Header, len(prev)+len(h))
+		for k, v := range prev {
+			merged[k] = v
+		}
+		for k, v := range h {
+			merged[http.CanonicalHeaderKey(k)] = v
+		}
+		h = merged
+	}
+	return context.WithValue(ctx, requestHeadersKey{}, h)
+}
+
+// withContextHeaders returns a copy of the request with the headers of its context
+// defined by WithRequestHeaders, if any
+func withContextHeaders(req *http.Request) *http.Request {
+	h, ok := req.Context().Value(requestHeadersKey{}).(http.Header)
+	if !ok || len(h) == 0 {
+		return req
+	}
+
+	req2 := new(http.Request)
+	*req2 = *req
+	req2.Header = make(http.Header, len(req.Header)+len(h))
+	for k, s := range req.Header {
+		req2.Header[k] = append([]string(nil), s...)
+	}
+
+	for k, s := range h {
+		k = http.CanonicalHeaderKey(k)
+		if _, set := req2.Header[k]; set || isProtectedHeader(k) {
+			continue
+		}
+		req2.Header[k] = append([]string(nil), s...)
+	}
+
+	return req2
+}
+
+// isProtectedHeader reports whether the header, in canonical form, is one of the protectedHeaders
+func isProtectedHeader(k string) bool {
+	for _, p := range protectedHeaders {
+		if k == p {
+			return true
+		}
+	}
+	return false
+}
+
+// Bool returns a pointer to the given bool value, it is useful to
+// define optional bool fields, e.g. EpicsOptions.Done.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// String returns a pointer to the given string value, it is useful to
+// define optional string fields, e.g. EpicUpdate.Name.
+func String(v string) *string {
+	return &v
+}
@@ -1,17 +1,25 @@
 package jira
 
 import (
+	"bytes"
+	"compress/gzip"
+	"compress/zlib"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
 	"os"
 	"reflect"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -30,6 +38,7 @@ func setup() (client *Client, mux *http.ServeMux, serverURL string, teardown fun
 
 	apiHandler := http.NewServeMux()
 	apiHandler.Handle(baseURLPath+"/", http.StripPrefix(baseURLPath, mux))
+	apiHandler.Handle("/api/", mux)
 	apiHandler.HandleFunc("/", func(w http.ResponseWriter, req *http.Request) {
 		fmt.Fprintln(os.Stderr, "FAIL: Client.BaseURL path prefix is not preserved in the request URL:")
 		fmt.Fprintln(os.Stderr)
@@ -48,10 +57,43 @@ func setup() (client *Client, mux *http.ServeMux, serverURL string, teardown fun
 	return client, mux, server.URL, server.Close
 }
 
+func TestNewClientBaseURL(t *testing.T) {
+	tests := []struct {
+		rawurl string
+		want   string
+	}{
+		{rawurl: "https://x.atlassian.net", want: "https://x.atlassian.net/rest/agile/1.0/"},
+		{rawurl: "https://x.atlassian.net/", want: "https://x.atlassian.net/rest/agile/1.0/"},
+		{rawurl: " https://x.atlassian.net/rest ", want: "https://x.atlassian.net/rest/agile/1.0/"},
+		{rawurl: "https://x.atlassian.net/rest/", want: "https://x.atlassian.net/rest/agile/1.0/"},
+		{rawurl: "https://x.atlassian.net/rest/agile/1.0", want: "https://x.atlassian.net/rest/agile/1.0/"},
+		{rawurl: "https://x.atlassian.net/rest/agile/1.0//", want: "https://x.atlassian.net/rest/agile/1.0/"},
+		{rawurl: "https://x.atlassian.net/rest/api/2/", want: "https://x.atlassian.net/rest/agile/1.0/"},
+		{rawurl: "https://x.atlassian.net/rest/api/latest", want: "https://x.atlassian.net/rest/agile/1.0/"},
+		{rawurl: "http://jira.mycompany.com:8080/jira", want: "http://jira.mycompany.com:8080/jira/rest/agile/1.0/"},
+	}
+	for _, test := range tests {
+		c, err := NewClient(test.rawurl, nil)
+		assert.Nil(t, err, test.rawurl)
+		assert.Equal(t, test.want, c.BaseURL.String(), test.rawurl)
+
+		req, err := c.NewRequest("GET", "epic/5", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, test.want+"epic/5", req.URL.String())
+	}
+}
+
+func TestNewClientInvalidBaseURL(t *testing.T) {
+	for _, rawurl := range []string{"", "x.atlassian.net", "x.atlassian.net/rest", "/rest/agile/1.0/", "ftp://x.atlassian.net", "https://", ":"} {
+		_, err := NewClient(rawurl, nil)
+		assert.NotNil(t, err, rawurl)
+	}
+}
+
 func TestNewRequest(t *testing.T) {
 	c, _ := NewClient(defaultBaseURL, nil)
 
-	inURL, outURL := "/foo", defaultBaseURL+"foo"
+	inURL, outURL := "/foo", defaultBaseURL+"rest/agile/1.0/foo"
 	inBody, outBody := &User{Login: "l"}, `{"login":"l"}`+"\n"
 	req, _ := c.NewRequest("GET", inURL, inBody)
 
@@ -65,7 +107,7 @@ func TestNewRequestInvalidJSON(t *testing.T) {
 	c, _ := NewClient(defaultBaseURL, nil)
 
 	type T struct {
-		A map[interface{}]interface{}
+		A func()
 	}
 	_, err := c.NewRequest("GET", ".", &T{})
 
@@ -95,6 +137,48 @@ func TestNewRequestEmptyBody(t *testing.T) {
 	assert.Nil(t, req.Body)
 }
 
+func TestNewRequestRaw(t *testing.T) {
+	c, _ := NewClient(defaultBaseURL, nil)
+
+	req, err := c.NewRequestRaw("PUT", "foo", strings.NewReader("<xml/>"), "application/xml")
+	assert.Nil(t, err)
+	assert.Equal(t, defaultBaseURL+"rest/agile/1.0/foo", req.URL.String())
+	assert.Equal(t, "application/xml", req.Header.Get("Content-Type"))
+	assert.Equal(t, int64(6), req.ContentLength)
+	assert.NotNil(t, req.GetBody)
+
+	body, _ := ioutil.ReadAll(req.Body)
+	assert.Equal(t, "<xml/>", string(body))
+}
+
+func TestNewRequestRawSeeker(t *testing.T) {
+	c, _ := NewClient(defaultBaseURL, nil)
+
+	r := struct{ io.ReadSeeker }{strings.NewReader("skip,content")}
+	r.Seek(5, io.SeekStart)
+
+	req, err := c.NewRequestRaw("POST", "foo", r, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "", req.Header.Get("Content-Type"))
+	assert.Equal(t, int64(7), req.ContentLength)
+
+	body, _ := ioutil.ReadAll(req.Body)
+	assert.Equal(t, "content", string(body))
+
+	again, err := req.GetBody()
+	assert.Nil(t, err)
+	body, _ = ioutil.ReadAll(again)
+	assert.Equal(t, "content", string(body))
+}
+
+func TestNewRequestRawReader(t *testing.T) {
+	c, _ := NewClient(defaultBaseURL, nil)
+
+	req, err := c.NewRequestRaw("POST", "foo", struct{ io.Reader }{strings.NewReader("content")}, "text/plain")
+	assert.Nil(t, err)
+	assert.Nil(t, req.GetBody)
+}
+
 func TestNewRequestErrorForNoTrailingSlash(t *testing.T) {
 	tests := []struct {
 		rawurl    string
@@ -253,3 +337,466 @@ func TestQueryParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestDoRateLimitHeaders(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "42")
+		w.Header().Set("X-RateLimit-Reset", "2021-02-13T10:30Z")
+		w.Header().Set("Retry-After", "7")
+		w.WriteHeader(http.StatusTooManyRequests)
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	resp, err := client.Do(context.Background(), req, nil)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, 42, resp.RateLimitRemaining)
+	assert.Equal(t, time.Date(2021, 2, 13, 10, 30, 0, 0, time.UTC), resp.RateLimitReset.UTC())
+	assert.Equal(t, 7*time.Second, resp.RetryAfter)
+}
+
+func TestDoErrorResponse(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	body := `{"errorMessages":["Epic not updated"],"errors":{"color":"Invalid color"}}`
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, body)
+	})
+
+	_, _, err := client.Epics.PartiallyUpdate(context.Background(), "5", &Epic{})
+
+	jiraErr, ok := err.(*JiraError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusBadRequest, jiraErr.StatusCode)
+	assert.Equal(t, []string{"Epic not updated"}, jiraErr.Messages)
+	assert.Equal(t, map[string]string{"color": "Invalid color"}, jiraErr.Errors)
+	assert.Equal(t, body, string(jiraErr.Body))
+	assert.Contains(t, err.Error(), "Invalid color")
+}
+
+func TestDoErrorResponseInvalidJSON(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, "<html>oops</html>")
+	})
+
+	req, _ := client.NewRequest("GET", ".", nil)
+	_, err := client.Do(context.Background(), req, nil)
+
+	jiraErr, ok := err.(*JiraError)
+	assert.True(t, ok)
+	assert.Equal(t, "<html>oops</html>", string(jiraErr.Body))
+	assert.Contains(t, err.Error(), "502 Bad Gateway")
+}
+
+func TestQueryParametersOptionalBool(t *testing.T) {
+	assert.Equal(t, "?done=false", QueryParameters(&EpicsOptions{Done: Bool(false)}))
+	assert.Equal(t, "?done=true", QueryParameters(&EpicsOptions{Done: Bool(true)}))
+	assert.Equal(t, "", QueryParameters(&EpicsOptions{Done: nil}))
+}
+
+func TestQueryParametersOptionalPointers(t *testing.T) {
+	type MyOptions struct {
+		Done    *bool   `query:"done"`
+		Name    *string `query:"name"`
+		StartAt *int    `query:"startAt"`
+		Since   *int64  `query:"since"`
+	}
+
+	zero, zero64 := 0, int64(0)
+	ten, ten64 := 10, int64(1000)
+
+	tests := []struct {
+		Name    string
+		Options *MyOptions
+		Values  url.Values
+	}{
+		{
+			Name:    "nil pointers are omitted",
+			Options: &MyOptions{},
+			Values:  url.Values{},
+		},
+		{
+			Name:    "false and zero values are sent",
+			Options: &MyOptions{Done: Bool(false), Name: String(""), StartAt: &zero, Since: &zero64},
+			Values:  url.Values{"done": {"false"}, "name": {""}, "startAt": {"0"}, "since": {"0"}},
+		},
+		{
+			Name:    "values are sent",
+			Options: &MyOptions{Done: Bool(true), Name: String("foo"), StartAt: &ten, Since: &ten64},
+			Values:  url.Values{"done": {"true"}, "name": {"foo"}, "startAt": {"10"}, "since": {"1000"}},
+		},
+		{
+			Name:    "some pointers are nil",
+			Options: &MyOptions{Done: Bool(false), StartAt: &zero},
+			Values:  url.Values{"done": {"false"}, "startAt": {"0"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			q := QueryParameters(test.Options)
+			values, err := url.ParseQuery(strings.TrimPrefix(q, "?"))
+			assert.Nil(t, err)
+			assert.Equal(t, test.Values, values)
+		})
+	}
+}
+
+func TestQueryParametersStringSlice(t *testing.T) {
+	assert.Equal(t, "?fields=summary,status", QueryParameters(&SearchOptions{Fields: []string{"summary", "status"}}))
+	assert.Equal(t, "", QueryParameters(&SearchOptions{Fields: []string{}}))
+}
+
+func TestQueryParametersStringSliceOptions(t *testing.T) {
+	type MyOptions struct {
+		Expand []string `query:"expand,comma"`
+		State  []string `query:"state,repeat"`
+	}
+
+	assert.Equal(t, "", QueryParameters(&MyOptions{Expand: []string{}, State: []string{}}))
+	assert.Equal(t, "?expand=names", QueryParameters(&MyOptions{Expand: []string{"names"}}))
+	assert.Equal(t, "?expand=names,schema", QueryParameters(&MyOptions{Expand: []string{"names", "schema"}}))
+	assert.Equal(t, "?state=active", QueryParameters(&MyOptions{State: []string{"active"}}))
+	assert.Equal(t, "?state=active&state=future", QueryParameters(&MyOptions{State: []string{"active", "future"}}))
+}
+
+func TestPaginationHasMore(t *testing.T) {
+	p := Pagination{StartAt: 50, MaxResults: 50}
+	assert.True(t, p.HasMore())
+	assert.Equal(t, 100, p.NextStartAt())
+
+	p.IsLast = true
+	assert.False(t, p.HasMore())
+}
+
+func TestPaginationTotal(t *testing.T) {
+	var withTotal, withoutTotal EpicWrap
+	assert.Nil(t, json.Unmarshal([]byte(`{"maxResults": 2,"startAt": 0,"total": 3,"values": []}`), &withTotal))
+	assert.Nil(t, json.Unmarshal([]byte(`{"maxResults": 2,"startAt": 0,"isLast": false,"values": []}`), &withoutTotal))
+
+	assert.True(t, withTotal.KnownTotal())
+	assert.Equal(t, 3, withTotal.Total)
+	assert.Equal(t, 3, withTotal.prealloc())
+	assert.False(t, withoutTotal.KnownTotal())
+	assert.Equal(t, 0, withoutTotal.prealloc())
+
+	assert.Equal(t, maxPrealloc, Pagination{Total: maxPrealloc + 1}.prealloc())
+}
+
+func TestListAllTotal(t *testing.T) {
+	for _, withTotal := range []bool{true, false} {
+		client, mux, _, teardown := setup()
+
+		mux.HandleFunc("/board/5/epic", func(w http.ResponseWriter, r *http.Request) {
+			total := ""
+			if withTotal {
+				total = `"total": 3,`
+			}
+			switch r.URL.Query().Get("startAt") {
+			case "":
+				fmt.Fprintf(w, `{"maxResults": 2,"startAt": 0,%s"values": [{"id": 1},{"id": 2}]}`, total)
+			case "2":
+				fmt.Fprintf(w, `{"maxResults": 2,"startAt": 2,%s"isLast": true,"values": [{"id": 3}]}`, total)
+			default:
+				t.Errorf("unexpected startAt %q", r.URL.Query().Get("startAt"))
+			}
+		})
+
+		epics, resp, err := client.Epics.ListAll(context.Background(), 5, &EpicsOptions{MaxResults: 2})
+		assert.Nil(t, err)
+		assert.Len(t, epics, 3)
+		assert.True(t, resp.IsLast)
+		assert.Equal(t, withTotal, resp.KnownTotal())
+		if withTotal {
+			assert.Equal(t, 3, resp.Total)
+			assert.Equal(t, 3, cap(epics))
+		}
+
+		teardown()
+	}
+}
+
+func TestResponseHasMore(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/board/1/epic", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("startAt") {
+		case "", "0":
+			fmt.Fprint(w, `{"maxResults": 3,"startAt": 0,"values": [{"id": 1},{"id": 2}]}`)
+		default:
+			fmt.Fprint(w, `{"maxResults": 3,"startAt": 2,"values": []}`)
+		}
+	})
+
+	_, resp, err := client.Boards.ListEpics(context.Background(), 1, nil)
+	assert.Nil(t, err)
+	assert.True(t, resp.HasMore())
+	assert.Equal(t, 2, resp.NextStartAt())
+
+	_, resp, err = client.Boards.ListEpics(context.Background(), 1, &EpicsOptions{StartAt: resp.NextStartAt()})
+	assert.Nil(t, err)
+	assert.False(t, resp.HasMore())
+	assert.Equal(t, 2, resp.NextStartAt())
+}
+
+func TestResponseNextStartAtNotPaginated(t *testing.T) {
+	resp := newResponse(&http.Response{Header: http.Header{}})
+	resp.StartAt = 10
+	resp.MaxResults = 5
+	assert.Equal(t, 15, resp.NextStartAt())
+}
+
+func gzipped(s string) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write([]byte(s))
+	w.Close()
+	return buf.Bytes()
+}
+
+func TestDoGzipResponse(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "gzip, deflate", r.Header.Get("Accept-Encoding"))
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(gzipped(`{"id": 5,"key": "MCP-5"}`))
+	})
+
+	epic, _, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-5", epic.Key)
+}
+
+func TestDoDeflateResponse(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "deflate")
+		zw := zlib.NewWriter(w)
+		zw.Write([]byte(`{"id": 5,"key": "MCP-5"}`))
+		zw.Close()
+	})
+
+	epic, _, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-5", epic.Key)
+}
+
+func TestDoGzipErrorResponse(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write(gzipped(`{"errorMessages": ["Epic does not exist"]}`))
+	})
+
+	_, _, err := client.Epics.Get(context.Background(), "5")
+	jiraErr, ok := err.(*JiraError)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"Epic does not exist"}, jiraErr.Messages)
+}
+
+// decompressingTransport decompresses the gzip responses, like a custom transport could
+type decompressingTransport struct{}
+
+func (decompressingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if err != nil || resp.Header.Get("Content-Encoding") != "gzip" {
+		return resp, err
+	}
+	zr, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(zr)
+	resp.Uncompressed = true
+	return resp, nil
+}
+
+func TestDoGzipResponseDecompressedByTransport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.Write(gzipped(`{"id": 5,"key": "MCP-5"}`))
+	})
+
+	c, _ := NewClient(defaultBaseURL, &http.Client{Transport: decompressingTransport{}})
+	c.BaseURL = client.BaseURL
+
+	epic, _, err := c.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-5", epic.Key)
+}
+
+func TestWithRequestHeaders(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "trace-1", r.Header.Get("X-Atlassian-Request-Id"))
+		assert.Equal(t, "abc", r.Header.Get("X-Correlation-Id"))
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"id": 5}`)
+	})
+
+	WithBearerToken("secret")(client)
+
+	ctx := WithRequestHeaders(context.Background(), http.Header{
+		"X-Atlassian-Request-Id": {"trace-1"},
+		"Authorization":          {"Basic dTpw"},
+	})
+	ctx = WithRequestHeaders(ctx, http.Header{"x-correlation-id": {"abc"}})
+
+	req, _ := client.NewRequest("GET", "epic/5", nil)
+	_, err := client.Do(ctx, req, nil)
+	assert.Nil(t, err)
+
+	// the request given is not modified
+	assert.Empty(t, req.Header.Get("X-Atlassian-Request-Id"))
+}
+
+func TestWithRequestHeadersContentType(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ctx := WithRequestHeaders(context.Background(), http.Header{"Content-Type": {"text/plain"}})
+	_, _, err := client.Epics.PartiallyUpdate(ctx, "5", &Epic{Name: "name"})
+	assert.Nil(t, err)
+}
+
+func TestWithIfNoneMatch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("ETag", `"v2"`)
+		if r.Header.Get("If-None-Match") == `"v2"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprint(w, `{"id": 5,"key": "MCP-5"}`)
+	})
+
+	epic, resp, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-5", epic.Key)
+	assert.Equal(t, `"v2"`, resp.ETag)
+	assert.False(t, resp.NotModified)
+
+	epic, resp, err = client.Epics.Get(WithIfNoneMatch(context.Background(), resp.ETag), "5")
+	assert.Nil(t, err)
+	assert.Nil(t, epic)
+	assert.True(t, resp.NotModified)
+	assert.Equal(t, http.StatusNotModified, resp.StatusCode)
+
+	epic, resp, err = client.Epics.Get(WithIfNoneMatch(context.Background(), `"v1"`), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-5", epic.Key)
+	assert.False(t, resp.NotModified)
+}
+
+func TestWithIfNoneMatchListIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `"v1"`, r.Header.Get("If-None-Match"))
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	issues, resp, err := client.Epics.ListIssues(WithIfNoneMatch(context.Background(), `"v1"`), "5", nil)
+	assert.Nil(t, err)
+	assert.Nil(t, issues)
+	assert.True(t, resp.NotModified)
+}
+
+func TestQueryParametersStringTypeSlice(t *testing.T) {
+	assert.Equal(t, "?state=active,closed", QueryParameters(&SprintsOptions{State: []SprintState{SprintStateActive, SprintStateClosed}}))
+	assert.Equal(t, "", QueryParameters(&SprintsOptions{State: []SprintState{}}))
+}
+
+// dialCountingTransport returns a transport counting the connections it dials in dials
+func dialCountingTransport(dials *int32) *http.Transport {
+	dialer := &net.Dialer{}
+	return &http.Transport{
+		DialContext: func(ctx context.Context, network, addr string) (net.Conn, error) {
+			atomic.AddInt32(dials, 1)
+			return dialer.DialContext(ctx, network, addr)
+		},
+	}
+}
+
+func TestDoDrainsBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var dials int32
+	client.client.Transport = dialCountingTransport(&dials)
+
+	mux.HandleFunc("/epic/MCP-1/issue", func(w http.ResponseWriter, r *http.Request) {
+		// a body ignored by MoveIssuesTo, larger than what http.Transport drains itself
+		body := fmt.Sprintf(`{"message":"%s"}`, strings.Repeat("x", 512<<10))
+		w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+		_, _ = fmt.Fprint(w, body)
+	})
+
+	for i := 0; i < 3; i++ {
+		_, _, err := client.Epics.MoveIssuesTo(context.Background(), "MCP-1", &IssueKeys{Issues: []string{"MCP-2"}})
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&dials))
+}
+
+func TestResponseDrain(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var dials int32
+	client.client.Transport = dialCountingTransport(&dials)
+
+	mux.HandleFunc("/api/2/attachment/content/10000", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Length", strconv.Itoa(512<<10))
+		_, _ = fmt.Fprint(w, strings.Repeat("x", 512<<10))
+	})
+
+	for i := 0; i < 3; i++ {
+		_, resp, err := client.Attachments.Download(context.Background(), "10000")
+		assert.Nil(t, err)
+		assert.Nil(t, resp.Drain())
+		assert.Nil(t, resp.Drain())
+		assert.Equal(t, http.NoBody, resp.Body)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&dials))
+
+	assert.Nil(t, (*Response)(nil).Drain())
+}
+
+func TestQueryParametersEscape(t *testing.T) {
+	opts := struct {
+		JQL  string `query:"jql,escape"`
+		Name string `query:"name"`
+	}{JQL: "key = MCP-1 & a", Name: "x"}
+	assert.Equal(t, "?jql=key+%3D+MCP-1+%26+a&name=x", QueryParameters(&opts))
+}
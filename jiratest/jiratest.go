@@ -0,0 +1,100 @@
+// Package jiratest provides utilities to test code that uses the jira package
+// against a fake Jira server.
+package jiratest
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+
+	"github.com/leocomelli/jira"
+)
+
+// AgilePath is the path of the Jira Agile API on the test server. The requests sent by the
+// services of the Agile API are received as AgilePath + "epic/5", for example, while the
+// ones of the platform API are received as /rest/api/2/issue/MCP-1.
+const AgilePath = "/rest/agile/1.0/"
+
+// NewTestClient starts a test server that serves the requests with handler and returns a
+// client pointed at it, configured with opts, and a function to close the server.
+func NewTestClient(handler http.Handler, opts ...jira.ClientOption) (*jira.Client, func()) {
+	server := httptest.NewServer(handler)
+
+	client, err := jira.NewClient(server.URL+AgilePath, server.Client(), opts...)
+	if err != nil {
+		server.Close()
+		panic("jiratest: " + err.Error())
+	}
+
+	return client, server.Close
+}
+
+// Request is a request received by a Responder
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+}
+
+// Responder is an http.Handler that replies all requests with a canned response
+// and records them, so tests can assert what was sent by the client.
+type Responder struct {
+	// StatusCode is the status code of the response. Default: 200.
+	StatusCode int
+	// Body is the body of the response, sent with the application/json content type.
+	Body string
+
+	mu       sync.Mutex
+	requests []*Request
+}
+
+// Respond returns a Responder that replies with the given status code and body
+func Respond(statusCode int, body string) *Responder {
+	return &Responder{StatusCode: statusCode, Body: body}
+}
+
+// ServeHTTP implements the http.Handler interface
+func (r *Responder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := ioutil.ReadAll(req.Body)
+
+	r.mu.Lock()
+	r.requests = append(r.requests, &Request{
+		Method: req.Method,
+		Path:   req.URL.Path,
+		Query:  req.URL.Query(),
+		Header: req.Header,
+		Body:   body,
+	})
+	r.mu.Unlock()
+
+	if r.Body != "" {
+		w.Header().Set("Content-Type", "application/json")
+	}
+	if r.StatusCode != 0 {
+		w.WriteHeader(r.StatusCode)
+	}
+	w.Write([]byte(r.Body))
+}
+
+// Requests returns the requests received so far, in order
+func (r *Responder) Requests() []*Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]*Request(nil), r.requests...)
+}
+
+// LastRequest returns the last request received, or nil if none was
+func (r *Responder) LastRequest() *Request {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.requests) == 0 {
+		return nil
+	}
+	return r.requests[len(r.requests)-1]
+}
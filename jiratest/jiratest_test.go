@@ -0,0 +1,48 @@
+package jiratest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/leocomelli/jira"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewTestClient(t *testing.T) {
+	responder := Respond(http.StatusNoContent, "")
+	client, teardown := NewTestClient(responder)
+	defer teardown()
+
+	moved, _, err := client.Epics.MoveIssuesTo(context.Background(), "MCP-5", &jira.IssueKeys{Issues: []string{"MCP-1", "MCP-2"}})
+	assert.Nil(t, err)
+	assert.True(t, moved)
+
+	req := responder.LastRequest()
+	assert.Equal(t, "POST", req.Method)
+	assert.Equal(t, AgilePath+"epic/MCP-5/issue", req.Path)
+	assert.JSONEq(t, `{"issues": ["MCP-1","MCP-2"]}`, string(req.Body))
+}
+
+func TestNewTestClientPlatformAPI(t *testing.T) {
+	responder := Respond(http.StatusOK, `{"id": "10000","key": "MCP-1"}`)
+	client, teardown := NewTestClient(responder)
+	defer teardown()
+
+	issue, _, err := client.Issues.GetIssue(context.Background(), "MCP-1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "10000", issue.ID)
+
+	assert.Len(t, responder.Requests(), 1)
+	assert.Equal(t, "/rest/api/2/issue/MCP-1", responder.LastRequest().Path)
+}
+
+func TestRespondError(t *testing.T) {
+	client, teardown := NewTestClient(Respond(http.StatusNotFound, `{"errorMessages": ["Epic does not exist"]}`))
+	defer teardown()
+
+	_, _, err := client.Epics.Get(context.Background(), "MCP-5")
+	jiraErr, ok := err.(*jira.JiraError)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"Epic does not exist"}, jiraErr.Messages)
+}
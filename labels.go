@@ -0,0 +1,88 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// LabelsService handles communication with the label related
+// methods of the Jira API
+//
+// Jira API docs: https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-labels
+type LabelsService service
+
+// LabelWrap represents the data returned by the API,
+// in addition to the labels, paging data is returned
+type LabelWrap struct {
+	Pagination
+	Values []string `json:"values,omitempty"`
+}
+
+// LabelOptions contains all options to list the labels
+type LabelOptions struct {
+	//The index of the first label to return (0-based)
+	StartAt int `query:"startAt"`
+	//The maximum number of labels to return per page. Default: 1000.
+	MaxResults int `query:"maxResults"`
+}
+
+// labelSuggestions represents the suggestions returned by the label autocomplete
+type labelSuggestions struct {
+	Token       string `json:"token,omitempty"`
+	Suggestions []struct {
+		Label string `json:"label,omitempty"`
+	} `json:"suggestions,omitempty"`
+}
+
+// List returns the labels used in the issues, a page at a time.
+//
+// GET /rest/api/2/label
+func (l *LabelsService) List(ctx context.Context, opts *LabelOptions) ([]string, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := l.client.NewRequest("GET", l.client.apiPath(fmt.Sprintf("label%s", q)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &LabelWrap{}
+	resp, err := l.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.MaxResults = wrap.MaxResults
+	resp.StartAt = wrap.StartAt
+	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
+
+	return wrap.Values, resp, nil
+}
+
+// Suggest returns the labels that match the query, as suggested by the label autocomplete
+// of the issue screens. An empty query returns the most used labels.
+//
+// GET /rest/api/1.0/labels/suggest?query={query}
+func (l *LabelsService) Suggest(ctx context.Context, query string) ([]string, *Response, error) {
+
+	req, err := l.client.NewRequest("GET", "../../api/1.0/labels/suggest?query="+url.QueryEscape(query), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var suggestions = &labelSuggestions{}
+	resp, err := l.client.Do(ctx, req, suggestions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	labels := make([]string, 0, len(suggestions.Suggestions))
+	for _, s := range suggestions.Suggestions {
+		labels = append(labels, s.Label)
+	}
+
+	return labels, resp, nil
+}
@@ -0,0 +1,42 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLabelsServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/label", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "2", r.URL.Query().Get("maxResults"))
+		fmt.Fprint(w, `{"maxResults": 2,"startAt": 0,"total": 3,"isLast": false,"values": ["backend","frontend"]}`)
+	})
+
+	labels, resp, err := client.Labels.List(context.Background(), &LabelOptions{MaxResults: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"backend", "frontend"}, labels)
+	assert.True(t, resp.HasMore())
+	assert.Equal(t, 2, resp.NextStartAt())
+}
+
+func TestLabelsServiceSuggest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/1.0/labels/suggest", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "back", r.URL.Query().Get("query"))
+		fmt.Fprint(w, `{"token": "back","suggestions": [{"label": "backend","html": "<b>back</b>end"},{"label": "backlog","html": "<b>back</b>log"}]}`)
+	})
+
+	labels, _, err := client.Labels.Suggest(context.Background(), "back")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"backend", "backlog"}, labels)
+}
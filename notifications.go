@@ -0,0 +1,85 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Notification represents an email notification about an issue sent by IssuesService.Notify.
+// The subject defaults to the summary of the issue.
+type Notification struct {
+	Subject  string                `json:"subject,omitempty"`
+	TextBody string                `json:"textBody,omitempty"`
+	HTMLBody string                `json:"htmlBody,omitempty"`
+	To       *NotificationTo       `json:"to,omitempty"`
+	Restrict *NotificationRestrict `json:"restrict,omitempty"`
+}
+
+// NotificationTo represents the recipients of a notification. The users are identified by
+// their account Id, or name on Jira Data Center, and the groups by their name or Id.
+type NotificationTo struct {
+	Reporter bool         `json:"reporter,omitempty"`
+	Assignee bool         `json:"assignee,omitempty"`
+	Watchers bool         `json:"watchers,omitempty"`
+	Voters   bool         `json:"voters,omitempty"`
+	Users    []*IssueUser `json:"users,omitempty"`
+	Groups   []*Group     `json:"groups,omitempty"`
+}
+
+// NotificationRestrict restricts the recipients of a notification to the members of the given
+// groups or to the users with the given permissions, e.g. BROWSE.
+type NotificationRestrict struct {
+	Groups      []*Group                  `json:"groups,omitempty"`
+	Permissions []*NotificationPermission `json:"permissions,omitempty"`
+}
+
+// NotificationPermission represents a permission restricting the recipients of a notification
+type NotificationPermission struct {
+	ID  string `json:"id,omitempty"`
+	Key string `json:"key,omitempty"`
+}
+
+// NotificationError is returned by IssuesService.Notify when the API refuses to send the
+// notification, e.g. when the outgoing emails are disabled or the user can not notify.
+type NotificationError struct {
+	// Issue is the id or key of the issue
+	Issue string
+	Err   *ErrorResponse
+}
+
+func (e *NotificationError) Error() string {
+	return fmt.Sprintf("jira: notification about issue %s refused: %v", e.Issue, e.Err)
+}
+
+// Unwrap returns the error returned by the API
+func (e *NotificationError) Unwrap() error {
+	return e.Err
+}
+
+// ErrNoRecipients is returned by IssuesService.Notify when the notification has no recipient
+var ErrNoRecipients = errors.New("jira: the notification has no recipient")
+
+// Notify sends an email notification about an issue, for a given issue Id or issue key, to the
+// recipients of the notification. If the notification is refused, e.g. the outgoing emails are
+// disabled, a *NotificationError is returned.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/notify
+func (i *IssuesService) Notify(ctx context.Context, issueIDOrKey string, n *Notification) (*Response, error) {
+	if n == nil || n.To == nil || !n.To.Reporter && !n.To.Assignee && !n.To.Watchers && !n.To.Voters && len(n.To.Users) == 0 && len(n.To.Groups) == 0 {
+		return nil, ErrNoRecipients
+	}
+
+	req, err := i.client.NewRequest("POST", i.client.apiPath(fmt.Sprintf("issue/%s/notify", issueIDOrKey)), n)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := i.client.Do(ctx, req, nil)
+	if errResp, ok := err.(*ErrorResponse); ok && errResp.StatusCode == http.StatusForbidden {
+		return resp, &NotificationError{Issue: issueIDOrKey, Err: errResp}
+	}
+
+	return resp, err
+}
@@ -0,0 +1,69 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuesServiceNotify(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/notify", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"subject": "Deploy blocked","textBody": "Please review","htmlBody": "<p>Please review</p>","to": {"reporter": true,"assignee": true,"users": [{"accountId": "5b10a2844c20165700ede21g"}],"groups": [{"name": "jira-developers"}]}}`, string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	n := &Notification{
+		Subject:  "Deploy blocked",
+		TextBody: "Please review",
+		HTMLBody: "<p>Please review</p>",
+		To: &NotificationTo{
+			Reporter: true,
+			Assignee: true,
+			Users:    []*IssueUser{{AccountID: "5b10a2844c20165700ede21g"}},
+			Groups:   []*Group{{Name: "jira-developers"}},
+		},
+	}
+	resp, err := client.Issues.Notify(context.Background(), "MCP-1", n)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestIssuesServiceNotifyForbidden(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/notify", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errorMessages": ["Outgoing emails are disabled."]}`)
+	})
+
+	_, err := client.Issues.Notify(context.Background(), "MCP-1", &Notification{To: &NotificationTo{Watchers: true}})
+	nerr, ok := err.(*NotificationError)
+	assert.True(t, ok)
+	assert.Equal(t, "MCP-1", nerr.Issue)
+	assert.Equal(t, []string{"Outgoing emails are disabled."}, nerr.Err.Messages)
+}
+
+func TestIssuesServiceNotifyNoRecipients(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/notify", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("notification without recipient sent to the API")
+	})
+
+	_, err := client.Issues.Notify(context.Background(), "MCP-1", &Notification{Subject: "Hi"})
+	assert.Equal(t, ErrNoRecipients, err)
+
+	_, err = client.Issues.Notify(context.Background(), "MCP-1", &Notification{To: &NotificationTo{}})
+	assert.Equal(t, ErrNoRecipients, err)
+}
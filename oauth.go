@@ -0,0 +1,83 @@
+package jira
+
+import (
+	"fmt"
+	"net/http"
+
+	"golang.org/x/oauth2"
+)
+
+// CloudGatewayURL returns the base URL of the Jira Agile API, for the given cloud id,
+// through the gateway used by the OAuth 2.0 (3LO) apps:
+// https://api.atlassian.com/ex/jira/{cloudId}/rest/agile/1.0/
+func CloudGatewayURL(cloudID string) string {
+	return fmt.Sprintf("https://api.atlassian.com/ex/jira/%s/rest/agile/1.0/", cloudID)
+}
+
+// TokenError reports a failure to get the OAuth 2.0 token used to authenticate a request.
+type TokenError struct {
+	Err error
+}
+
+func (e *TokenError) Error() string {
+	return "jira: unable to get the oauth2 token: " + e.Err.Error()
+}
+
+// Unwrap returns the error returned by the token source.
+func (e *TokenError) Unwrap() error {
+	return e.Err
+}
+
+// tokenSourceTransport is an http.RoundTripper that authenticates all requests
+// using a bearer token obtained from an oauth2.TokenSource.
+type tokenSourceTransport struct {
+	Source oauth2.TokenSource
+	Base   http.RoundTripper
+}
+
+// RoundTrip implements the RoundTripper interface. The token is not sent when the request
+// follows a redirect to another host, as the http.Client does for the Authorization header
+// of the request, see WithBearerToken.
+func (t *tokenSourceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if redirectedFrom(req).URL.Host != req.URL.Host {
+		if t.Base != nil {
+			return t.Base.RoundTrip(req)
+		}
+		return http.DefaultTransport.RoundTrip(req)
+	}
+
+	token, err := t.Source.Token()
+	if err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		if impErr, ok := err.(*ImpersonationError); ok {
+			return nil, impErr
+		}
+		return nil, &TokenError{Err: err}
+	}
+
+	// The Request given must not be modified, see BasicAuthTransport.RoundTrip.
+	req2 := new(http.Request)
+	*req2 = *req
+	req2.Header = make(http.Header, len(req.Header))
+	for k, s := range req.Header {
+		req2.Header[k] = append([]string(nil), s...)
+	}
+
+	token.SetAuthHeader(req2)
+
+	if t.Base != nil {
+		return t.Base.RoundTrip(req2)
+	}
+	return http.DefaultTransport.RoundTrip(req2)
+}
+
+// redirectedFrom returns the request first sent by the http.Client when req follows redirects,
+// or req itself
+func redirectedFrom(req *http.Request) *http.Request {
+	for req.Response != nil && req.Response.Request != nil {
+		req = req.Response.Request
+	}
+	return req
+}
@@ -0,0 +1,93 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/oauth2"
+)
+
+type failingTokenSource struct{}
+
+func (failingTokenSource) Token() (*oauth2.Token, error) {
+	return nil, errors.New("refresh token revoked")
+}
+
+func TestWithTokenSource(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+		w.Write([]byte(`{"id": 5}`))
+	})
+
+	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "my-token"})
+	client, err := NewClient(serverURL+baseURLPath+"/", nil, WithTokenSource(ts))
+	assert.Nil(t, err)
+
+	_, _, err = client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+}
+
+func TestWithTokenSourceCrossHostRedirect(t *testing.T) {
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "", r.Header.Get("Authorization"))
+		w.Write([]byte(`{"id": 6}`))
+	}))
+	defer other.Close()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+		http.Redirect(w, r, baseURLPath+"/epic/6", http.StatusFound)
+	})
+	mux.HandleFunc("/epic/6", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+		http.Redirect(w, r, other.URL+"/epic/6", http.StatusFound)
+	})
+
+	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "my-token"})
+	client, err := NewClient(serverURL+baseURLPath+"/", nil, WithTokenSource(ts))
+	assert.Nil(t, err)
+
+	epic, _, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, 6, epic.ID)
+}
+
+func TestWithTokenSourceError(t *testing.T) {
+	_, _, serverURL, teardown := setup()
+	defer teardown()
+
+	client, _ := NewClient(serverURL+baseURLPath+"/", nil, WithTokenSource(failingTokenSource{}))
+
+	_, _, err := client.Epics.Get(context.Background(), "5")
+
+	tokenErr, ok := err.(*TokenError)
+	assert.True(t, ok)
+	assert.Equal(t, "refresh token revoked", tokenErr.Unwrap().Error())
+}
+
+func TestWithTokenSourceConflictingAuth(t *testing.T) {
+	ts := oauth2.StaticTokenSource(&oauth2.Token{AccessToken: "my-token"})
+
+	_, err := NewClient(defaultBaseURL, nil, WithTokenSource(ts), WithBearerToken("my-token"))
+	assert.Equal(t, ErrConflictingAuth, err)
+}
+
+func TestCloudGatewayURL(t *testing.T) {
+	c, _ := NewClient(CloudGatewayURL("abc-123"), nil)
+
+	req, _ := c.NewRequest("GET", c.apiPath("issue/MCP-1"), nil)
+	assert.Equal(t, "https://api.atlassian.com/ex/jira/abc-123/rest/api/2/issue/MCP-1", req.URL.String())
+
+	req, _ = c.NewRequest("GET", "epic/5", nil)
+	assert.Equal(t, "https://api.atlassian.com/ex/jira/abc-123/rest/agile/1.0/epic/5", req.URL.String())
+}
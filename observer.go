@@ -0,0 +1,127 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Observer is notified of every API call made by the client, e.g. to record metrics.
+// It is registered by WithObserver.
+type Observer interface {
+	ObserveRequest(ctx context.Context, info RequestInfo)
+}
+
+// RequestInfo describes an API call, once it is completed
+type RequestInfo struct {
+	// Method is the HTTP method of the request
+	Method string
+	// Route is the path of the request with the ids replaced by {id}, relative to the
+	// Agile API, e.g. epic/{id}/issue, or prefixed by the platform API version, e.g.
+	// api/2/issue/{id}. Its cardinality is bounded, so it can be used as a metric label.
+	Route string
+	// StatusCode is the status code of the response, or 0 if none was received
+	StatusCode int
+	// Duration is the time spent on the call, including retries
+	Duration time.Duration
+	// Retries is the number of attempts made after the first one
+	Retries int
+	// Err is the error returned to the caller, if any
+	Err error
+}
+
+// observe notifies the observer defined by WithObserver of an API call
+func (c *Client) observe(ctx context.Context, req *http.Request, resp *Response, err error, attempts int, d time.Duration) {
+	info := RequestInfo{
+		Method:   req.Method,
+		Route:    c.route(req),
+		Duration: d,
+		Err:      err,
+	}
+	if attempts > 0 {
+		info.Retries = attempts - 1
+	}
+	if resp != nil && resp.Response != nil {
+		info.StatusCode = resp.StatusCode
+	}
+
+	c.observer.ObserveRequest(ctx, info)
+}
+
+// route returns the route of the request, see RequestInfo.Route
+func (c *Client) route(req *http.Request) string {
+	p := req.URL.Path
+
+	if req.URL.Host == c.BaseURL.Host {
+		if strings.HasPrefix(p, c.BaseURL.Path) {
+			return normalizeRoute(strings.TrimPrefix(p, c.BaseURL.Path))
+		}
+		if api, err := c.BaseURL.Parse(c.apiPath("")); err == nil && strings.HasPrefix(p, api.Path) {
+			return "api/" + c.version() + "/" + normalizeRoute(strings.TrimPrefix(p, api.Path))
+		}
+	}
+
+	return normalizeRoute(strings.TrimPrefix(p, "/"))
+}
+
+// routeCollections are the segments of the Jira API paths followed by an id, a key or a
+// name chosen by the users, e.g. the key of a property, with the literal segments that may
+// follow them instead, e.g. issue/createmeta.
+var routeCollections = map[string][]string{
+	"attachment": {"content", "meta"},
+	"board":      nil,
+	"comment":    nil,
+	"component":  nil,
+	"content":    nil,
+	"dashboard":  nil,
+	"epic":       {"none"},
+	"filter":     {"search", "favourite", "my"},
+	"issue":      {"createmeta", "bulk", "picker"},
+	"issueLink":  nil,
+	"issuetype":  nil,
+	"priority":   nil,
+	"project":    {"search"},
+	"properties": nil,
+	"remotelink": nil,
+	"resolution": nil,
+	"sprint":     nil,
+	"status":     nil,
+	"version":    nil,
+	"worklog":    nil,
+}
+
+// normalizeRoute replaces the ids in the path by {id}: the segments following a collection,
+// see routeCollections, and those not looking like the literal segments of the Jira API
+// paths, which start with a lowercase letter and contain no digits, e.g. issueLink, while
+// ids, keys and names usually do not, e.g. 10001, MCP-1 or MCP.
+func normalizeRoute(p string) string {
+	segments := strings.Split(p, "/")
+	for i, s := range segments {
+		if s == "" {
+			continue
+		}
+		if i > 0 && isRouteID(segments[i-1], s) {
+			segments[i] = "{id}"
+			continue
+		}
+		if s[0] < 'a' || s[0] > 'z' || strings.ContainsAny(s, "0123456789") {
+			segments[i] = "{id}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// isRouteID reports whether s, following the segment prev, is an id
+func isRouteID(prev, s string) bool {
+	literals, ok := routeCollections[prev]
+	if !ok {
+		return false
+	}
+	for _, l := range literals {
+		if s == l {
+			return false
+		}
+	}
+	return true
+}
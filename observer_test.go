@@ -0,0 +1,85 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordingObserver struct {
+	infos []RequestInfo
+}
+
+func (o *recordingObserver) ObserveRequest(ctx context.Context, info RequestInfo) {
+	o.infos = append(o.infos, info)
+}
+
+func TestWithObserver(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5/issue", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 50,"isLast": true,"issues": []}`)
+	})
+	mux.HandleFunc("/api/2/issue/MCP-1/worklog/100", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	o := &recordingObserver{}
+	WithObserver(o)(client)
+
+	client.Epics.ListIssues(context.Background(), "5", nil)
+	client.Worklogs.Delete(context.Background(), "MCP-1", "100", nil)
+
+	assert.Len(t, o.infos, 2)
+	assert.Equal(t, "GET", o.infos[0].Method)
+	assert.Equal(t, "epic/{id}/issue", o.infos[0].Route)
+	assert.Equal(t, http.StatusOK, o.infos[0].StatusCode)
+	assert.Equal(t, "DELETE", o.infos[1].Method)
+	assert.Equal(t, "api/2/issue/{id}/worklog/{id}", o.infos[1].Route)
+	assert.Equal(t, http.StatusNoContent, o.infos[1].StatusCode)
+}
+
+func TestWithObserverRetries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/epic/MCP-5", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"id": 5}`)
+	})
+
+	o := &recordingObserver{}
+	WithObserver(o)(client)
+	WithRetry(3, time.Millisecond)(client)
+
+	_, _, err := client.Epics.Get(context.Background(), "MCP-5")
+	assert.Nil(t, err)
+	assert.Len(t, o.infos, 1)
+	assert.Equal(t, "epic/{id}", o.infos[0].Route)
+	assert.Equal(t, 2, o.infos[0].Retries)
+	assert.Nil(t, o.infos[0].Err)
+}
+
+func TestNormalizeRoute(t *testing.T) {
+	assert.Equal(t, "board/{id}/sprint", normalizeRoute("board/12/sprint"))
+	assert.Equal(t, "project/{id}/statuses", normalizeRoute("project/MCP/statuses"))
+	assert.Equal(t, "issueLinkType", normalizeRoute("issueLinkType"))
+	assert.Equal(t, "user", normalizeRoute("user"))
+	assert.Equal(t, "issue/{id}/properties/{id}", normalizeRoute("issue/MCP-1/properties/myprop"))
+	assert.Equal(t, "board/{id}/properties/{id}", normalizeRoute("board/12/properties/colors"))
+	assert.Equal(t, "board/{id}/epic/none/issue", normalizeRoute("board/12/epic/none/issue"))
+	assert.Equal(t, "issue/createmeta", normalizeRoute("issue/createmeta"))
+	assert.Equal(t, "project/search", normalizeRoute("project/search"))
+	assert.Equal(t, "project/{id}/statuses", normalizeRoute("project/abc/statuses"))
+	assert.Equal(t, "attachment/content/{id}", normalizeRoute("attachment/content/10000"))
+}
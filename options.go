@@ -0,0 +1,315 @@
+package jira
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// ClientOption configures optional behaviors of the Client, see NewClient.
+type ClientOption func(*Client) error
+
+// WithRequestTimeout defines the maximum duration of each request sent by the client,
+// even if the context given by the caller has no deadline. The context given by the
+// caller is still honored when it is canceled or its deadline is shorter. When the
+// request is retried, the timeout applies to each attempt, not to all of them.
+func WithRequestTimeout(d time.Duration) ClientOption {
+	return func(c *Client) error {
+		c.requestTimeout = d
+		return nil
+	}
+}
+
+// WithRetry enables the retry of requests throttled (429) or failed due to server
+// errors (5xx) or network timeouts. A failed request is sent again at most maxRetries
+// times, waiting an exponential backoff with jitter based on baseDelay, of at most a
+// minute, between the attempts, or the Retry-After header value when throttled. Only
+// idempotent methods (GET, HEAD) are retried unless others are enabled by WithRetryMethods.
+func WithRetry(maxRetries int, baseDelay time.Duration) ClientOption {
+	return func(c *Client) error {
+		c.retry.maxRetries = maxRetries
+		c.retry.baseDelay = baseDelay
+		return nil
+	}
+}
+
+// WithRetryBudget bounds the retries enabled by WithRetry, so that they do not amplify the load of
+// a failing Jira instance, e.g. when many goroutines retry at the same time: once the retries exceed
+// the given ratio of the requests sent by the client over the last 10 seconds, the failed requests
+// are no longer retried, their error being returned at once. A few retries are always allowed, so
+// that a client sending few requests can still retry them. See Client.RetryBudget for its state.
+func WithRetryBudget(ratio float64) ClientOption {
+	return func(c *Client) error {
+		if ratio <= 0 {
+			return fmt.Errorf("jira: invalid retry budget %v, it must be positive", ratio)
+		}
+		c.retry.budget = newRetryBudget(ratio)
+		return nil
+	}
+}
+
+// WithRetryMethods defines the HTTP methods retried when WithRetry is enabled,
+// e.g. WithRetryMethods(http.MethodGet, http.MethodPost) also retries POST requests.
+func WithRetryMethods(methods ...string) ClientOption {
+	return func(c *Client) error {
+		c.retry.methods = make(map[string]bool, len(methods))
+		for _, m := range methods {
+			c.retry.methods[m] = true
+		}
+		return nil
+	}
+}
+
+// WithFieldCache defines for how long the fields listed by FieldsService.FindByName and
+// EpicsService.EpicNameFieldID, and the issue types listed by IssueTypesService.FindByName,
+// are cached. By default, they are cached until InvalidateCache is called on the service.
+func WithFieldCache(ttl time.Duration) ClientOption {
+	return func(c *Client) error {
+		c.fields.ttl = ttl
+		c.issueTypes.ttl = ttl
+		return nil
+	}
+}
+
+// WithLogger defines a function called after each request sent by the client, e.g. to log
+// its method, URL, status and latency. It is called for failed requests too, in which case
+// resp is nil, and once per attempt when the request is retried. The credentials in the
+// request headers are redacted and neither body is available to the function.
+func WithLogger(fn func(req *http.Request, resp *http.Response, duration time.Duration)) ClientOption {
+	return func(c *Client) error {
+		c.logger = fn
+		return nil
+	}
+}
+
+// WithObserver registers an Observer notified of every API call made by the client, once
+// it is completed, with its route, status, duration and number of retries.
+func WithObserver(o Observer) ClientOption {
+	return func(c *Client) error {
+		c.observer = o
+		return nil
+	}
+}
+
+// WithMaxResponseBytes limits the size of the response bodies read by the client to n bytes,
+// ErrResponseTooLarge is returned when a body exceeds it. By default, the size is unlimited.
+// The content of the attachments, streamed by AttachmentsService.Download, is not limited.
+func WithMaxResponseBytes(n int64) ClientOption {
+	return func(c *Client) error {
+		c.maxResponseBytes = n
+		return nil
+	}
+}
+
+// maxPageSize is the largest page size accepted by most Jira endpoints
+const maxPageSize = 100
+
+// WithDefaultPageSize defines the page size requested by the methods following the pagination,
+// e.g. EpicsService.ListAll or SearchService.SearchAll, when MaxResults is not set in their
+// options. Sizes above 100, the largest accepted by most endpoints, which would silently
+// return smaller pages, are rejected. By default, the page size is the server default.
+func WithDefaultPageSize(n int) ClientOption {
+	return func(c *Client) error {
+		if n <= 0 {
+			return fmt.Errorf("jira: invalid page size %d, it must be positive", n)
+		}
+		if n > maxPageSize {
+			return fmt.Errorf("jira: invalid page size %d, it must be at most %d", n, maxPageSize)
+		}
+		c.defaultPageSize = n
+		return nil
+	}
+}
+
+// WithPageConcurrency defines how many pages EpicsService.ListIssuesAll and
+// EpicsService.ListIssuesWithoutEpicAll request at a time once the total number of issues
+// is known from the first page. The issues are still returned in the order of a sequential
+// fetch. When the server does not return the total, the pages are requested one at a time,
+// which is also the default.
+func WithPageConcurrency(n int) ClientOption {
+	return func(c *Client) error {
+		if n <= 0 {
+			return fmt.Errorf("jira: invalid page concurrency %d, it must be positive", n)
+		}
+		c.pageConcurrency = n
+		return nil
+	}
+}
+
+// WithRetainResponseBody keeps the body of each response in Response.RawBody, e.g. to inspect
+// the fields returned by the API that are not mapped to a struct. The body is kept for error
+// responses too and its size is limited by WithMaxResponseBytes. It is disabled by default,
+// since it costs a copy of every body.
+func WithRetainResponseBody() ClientOption {
+	return func(c *Client) error {
+		c.retainResponseBody = true
+		return nil
+	}
+}
+
+// WithResponseCache caches the responses to GET requests that have an entity tag, e.g. those of
+// BoardsService.GetConfiguration or FieldsService.List. The next GET request to the same URL is
+// sent with If-None-Match and, when the server answers 304 Not Modified, the cached body is
+// decoded as if it had been returned, with Response.FromCache set. A request with any other
+// method discards the responses cached for the URLs of its path. See NewMemoryCache.
+func WithResponseCache(cache Cache) ClientOption {
+	return func(c *Client) error {
+		c.cache = cache
+		return nil
+	}
+}
+
+// WithRateLimiter throttles the requests sent by the client, each one waiting for rl before being
+// sent, e.g. WithRateLimiter(rate.NewLimiter(10, 5)) with golang.org/x/time/rate. The retried
+// attempts wait too, so they are counted by the limiter. The wait is aborted when the context
+// of the request is done, its error being returned.
+func WithRateLimiter(rl RateLimiter) ClientOption {
+	return func(c *Client) error {
+		c.rateLimiter = rl
+		return nil
+	}
+}
+
+// WithRequestCoalescing shares the response of a GET request among the identical ones,
+// i.e. with the same method and URL, sent concurrently by the client, e.g. by goroutines
+// calling FieldsService.List at the same time, so that a single request is sent. Each
+// caller decodes its own copy of the response. Errors are not shared, a caller whose
+// request failed while shared sends it again by itself.
+func WithRequestCoalescing() ClientOption {
+	return func(c *Client) error {
+		c.coalescing = &flightGroup{}
+		return nil
+	}
+}
+
+// WithSelfLinkHosts allows GetFromSelfLink to fetch the self links on the given hosts, e.g.
+// api.atlassian.com, in addition to the host of the BaseURL. The credentials of the client are
+// sent to these hosts, only over https.
+func WithSelfLinkHosts(hosts ...string) ClientOption {
+	return func(c *Client) error {
+		c.selfLinkHosts = append(c.selfLinkHosts, hosts...)
+		return nil
+	}
+}
+
+// WithHTTPTrace reports the timings of each request sent by the client, e.g. by EpicsService.Get,
+// to fn once the response headers are received: DNS lookup, connection, TLS handshake, time to
+// first byte, and whether the connection was reused. Each attempt of a retried request is reported.
+// fn is called by the goroutine sending the request and must not block.
+func WithHTTPTrace(fn func(info TraceInfo)) ClientOption {
+	return func(c *Client) error {
+		c.httpTrace = fn
+		return nil
+	}
+}
+
+// WithExecuteAs runs the requests sent by the client on behalf of the user of the given account Id,
+// e.g. for an automation run by a service account, with the user impersonation of the Atlassian
+// Connect apps on Jira Cloud: the client gets, and renews, a token acting as the user from the
+// authorization server of Atlassian, with the credentials of the app set by WithConnectApp. The app
+// must have the ACT_AS_USER scope, and the requests are then subject to the permissions of the user.
+// When the token is refused, the requests return an *ImpersonationError, matching
+// ErrImpersonationDenied. The base URL must be the one of the instance, not CloudGatewayURL. It can
+// not be used with another authentication, e.g. WithBearerToken.
+func WithExecuteAs(accountID string) ClientOption {
+	return func(c *Client) error {
+		if accountID == "" {
+			return errors.New("jira: the account id to execute as must not be empty")
+		}
+		c.executeAs = accountID
+		return nil
+	}
+}
+
+// WithConnectApp defines the credentials of the Atlassian Connect app the client acts for, used by
+// WithExecuteAs: the OAuth client id and the shared secret received by the app when installed on
+// the instance. The scopes of the tokens, e.g. READ or WRITE, default to those of the app.
+func WithConnectApp(oauthClientID, sharedSecret string, scopes ...string) ClientOption {
+	return func(c *Client) error {
+		if oauthClientID == "" || sharedSecret == "" {
+			return errors.New("jira: the oauth client id and the shared secret of the app must not be empty")
+		}
+		c.connectApp = &connectApp{oauthClientID: oauthClientID, sharedSecret: sharedSecret, scopes: scopes}
+		return nil
+	}
+}
+
+// WithUserAgent defines the User-Agent header of the requests sent by the client, go-jira/<version>
+// by default, so the Jira administrators can tell them apart in the logs. An empty ua leaves the
+// header unset, e.g. when the transport of the http.Client sets it; a transport setting it with
+// Header.Set replaces the one of the client rather than adding a second one.
+func WithUserAgent(ua string) ClientOption {
+	return func(c *Client) error {
+		c.userAgent = ua
+		return nil
+	}
+}
+
+// WithAPIVersion defines the version of the Jira platform REST API used by the client,
+// e.g. /rest/api/3. Valid values: 2 (default) and 3, available only on Jira Cloud.
+// On the version 3, the rich text fields (the issue description and environment, the
+// comments and the worklog comments) are ADF documents: they are decoded in the Document
+// fields, e.g. IssueField.DescriptionDocument, and the plain text is sent converted by
+// TextToADF. The Jira Agile API is not affected.
+func WithAPIVersion(version string) ClientOption {
+	return func(c *Client) error {
+		if version != "2" && version != "3" {
+			return fmt.Errorf("jira: invalid API version %q, valid values are 2 and 3", version)
+		}
+		c.apiVersion = version
+		c.apiVersionSet = true
+		return nil
+	}
+}
+
+// WithAutoAPIVersion makes the client use the version 3 of the Jira platform REST API with
+// Jira Cloud and the version 2 with Jira Server and Data Center. The deployment type is
+// requested before the first API call, see Client.IsCloud, and kept for the lifetime of
+// the client. The rich text fields are handled as with WithAPIVersion("3") on Jira Cloud.
+// WithAPIVersion takes precedence over it. The Jira Agile API is not affected.
+func WithAutoAPIVersion() ClientOption {
+	return func(c *Client) error {
+		c.autoAPIVersion = true
+		return nil
+	}
+}
+
+// WithHTTPClient defines the http.Client used to send all requests, e.g. to configure
+// a proxy, TLS or connection pooling. It replaces the httpClient given to NewClient.
+// When both the http.Client Timeout and WithRequestTimeout are defined, the shorter one
+// prevails, the Timeout also applies to each attempt.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) error {
+		if httpClient == nil {
+			return errors.New("jira: http client must not be nil")
+		}
+		c.client = httpClient
+		return nil
+	}
+}
+
+// WithBearerToken authenticates all requests using the given token in the Authorization
+// header, e.g. the Personal Access Tokens of Jira Data Center. It cannot be used along
+// with BasicAuthTransport or WithTokenSource, NewClient returns ErrConflictingAuth in that case. The header
+// is not forwarded when the request is redirected to another host.
+func WithBearerToken(token string) ClientOption {
+	return func(c *Client) error {
+		c.bearerToken = token
+		return nil
+	}
+}
+
+// WithTokenSource authenticates all requests using the OAuth 2.0 tokens obtained from the
+// given token source, e.g. for Jira Cloud OAuth 2.0 (3LO) apps. The tokens are reused until
+// they expire and refreshed by the token source. If a token cannot be obtained, Do returns
+// a *TokenError. OAuth 2.0 apps must use the gateway base URL, see CloudGatewayURL. It
+// cannot be used along with other authentications, see WithBearerToken.
+func WithTokenSource(ts oauth2.TokenSource) ClientOption {
+	return func(c *Client) error {
+		c.tokenSource = ts
+		return nil
+	}
+}
@@ -0,0 +1,552 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewClientWithOptions(t *testing.T) {
+	c, err := NewClient(defaultBaseURL, nil, WithRequestTimeout(time.Second))
+
+	assert.Nil(t, err)
+	assert.Equal(t, time.Second, c.requestTimeout)
+}
+
+func TestWithRequestTimeout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRequestTimeout(10 * time.Millisecond)(client)
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+
+	_, _, err := client.Epics.Get(context.Background(), "5")
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestWithRequestTimeoutParentContext(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRequestTimeout(time.Minute)(client)
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, _, err := client.Epics.Get(ctx, "5")
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestWithAPIVersion(t *testing.T) {
+	c, err := NewClient(defaultBaseURL, nil, WithAPIVersion("3"))
+	assert.Nil(t, err)
+	assert.Equal(t, "../../api/3/issue", c.apiPath("issue"))
+
+	_, err = NewClient(defaultBaseURL, nil, WithAPIVersion("4"))
+	assert.NotNil(t, err)
+}
+
+type countingTransport struct {
+	requests int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestWithHTTPClient(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"id": 5}`))
+	})
+
+	tp := &countingTransport{}
+	err := WithHTTPClient(&http.Client{Transport: tp})(client)
+	assert.Nil(t, err)
+
+	_, _, err = client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, tp.requests)
+
+	_, err = NewClient(defaultBaseURL, nil, WithHTTPClient(nil))
+	assert.NotNil(t, err)
+}
+
+func TestWithUserAgent(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var agents [][]string
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		agents = append(agents, r.Header["User-Agent"])
+		w.Write([]byte(`{"id": 5}`))
+	})
+	mux.HandleFunc("/api/2/issue/MCP-1/attachments", func(w http.ResponseWriter, r *http.Request) {
+		agents = append(agents, r.Header["User-Agent"])
+		w.Write([]byte(`[]`))
+	})
+
+	_, _, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+
+	assert.Nil(t, WithUserAgent("my-automation/2.0")(client))
+	_, _, err = client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	_, _, err = client.Attachments.Upload(context.Background(), "MCP-1", "a.txt", strings.NewReader("a"))
+	assert.Nil(t, err)
+
+	assert.Equal(t, [][]string{{"go-jira/" + libraryVersion}, {"my-automation/2.0"}, {"my-automation/2.0"}}, agents)
+}
+
+// userAgentTransport sets the User-Agent of the requests it sends
+type userAgentTransport struct {
+	ua string
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req2 := new(http.Request)
+	*req2 = *req
+	req2.Header = make(http.Header, len(req.Header))
+	for k, s := range req.Header {
+		req2.Header[k] = append([]string(nil), s...)
+	}
+	req2.Header.Set("User-Agent", t.ua)
+	return http.DefaultTransport.RoundTrip(req2)
+}
+
+func TestWithUserAgentTransport(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, []string{"transport/1.0"}, r.Header["User-Agent"])
+		w.Write([]byte(`{"id": 5}`))
+	})
+
+	client.client.Transport = &userAgentTransport{ua: "transport/1.0"}
+	_, _, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+
+	assert.Nil(t, WithUserAgent("")(client))
+	req, err := client.NewRequest("GET", "epic/5", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "", req.Header.Get("User-Agent"))
+	_, err = client.Do(context.Background(), req, nil)
+	assert.Nil(t, err)
+}
+
+func TestNewClientDefaultHTTPClient(t *testing.T) {
+	c, _ := NewClient(defaultBaseURL, nil)
+
+	assert.False(t, c.client == http.DefaultClient)
+}
+
+func TestWithBearerToken(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithBearerToken("my-token")(client)
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer my-token", r.Header.Get("Authorization"))
+		w.Write([]byte(`{"id": 5}`))
+	})
+
+	_, _, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+}
+
+func TestWithBearerTokenCrossHostRedirect(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithBearerToken("my-token")(client)
+
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Empty(t, r.Header.Get("Authorization"))
+		w.Write([]byte(`{"id": 5}`))
+	}))
+	defer other.Close()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		u, _ := url.Parse(other.URL)
+		u.Host = "localhost:" + u.Port()
+		http.Redirect(w, r, u.String(), http.StatusFound)
+	})
+
+	_, _, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+}
+
+func TestWithBearerTokenConflictingAuth(t *testing.T) {
+	tp := &BasicAuthTransport{Username: "u", Password: "p"}
+
+	_, err := NewClient(defaultBaseURL, tp.Client(), WithBearerToken("my-token"))
+	assert.Equal(t, ErrConflictingAuth, err)
+}
+
+func TestWithLogger(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+		fmt.Fprint(w, `{"id": 5,"key": "MCP-5"}`)
+	})
+
+	var logged []string
+	WithBearerToken("secret")(client)
+	WithLogger(func(req *http.Request, resp *http.Response, d time.Duration) {
+		assert.Equal(t, "REDACTED", req.Header.Get("Authorization"))
+		assert.Equal(t, http.NoBody, resp.Body)
+		assert.True(t, d > 0)
+		logged = append(logged, fmt.Sprintf("%s %s %d", req.Method, req.URL.Path, resp.StatusCode))
+	})(client)
+
+	epic, _, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-5", epic.Key)
+	assert.Equal(t, []string{"GET /agile/1.0/epic/5 200"}, logged)
+}
+
+func TestWithLoggerFailedRequest(t *testing.T) {
+	client, _, _, teardown := setup()
+	teardown()
+
+	called := false
+	WithLogger(func(req *http.Request, resp *http.Response, d time.Duration) {
+		called = true
+		assert.Nil(t, resp)
+		assert.Equal(t, "GET", req.Method)
+	})(client)
+
+	_, _, err := client.Epics.Get(context.Background(), "5")
+	assert.NotNil(t, err)
+	assert.True(t, called)
+}
+
+func TestWithMaxResponseBytes(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	body := `{"id": 5,"key": "MCP-5","name": "epic name"}`
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, body)
+	})
+
+	WithMaxResponseBytes(int64(len(body)))(client)
+	epic, _, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-5", epic.Key)
+
+	WithMaxResponseBytes(10)(client)
+	_, _, err = client.Epics.Get(context.Background(), "5")
+	assert.Equal(t, ErrResponseTooLarge, err)
+}
+
+func TestWithMaxResponseBytesErrorResponse(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages": ["a very long error message"]}`)
+	})
+
+	WithMaxResponseBytes(10)(client)
+	_, resp, err := client.Epics.Get(context.Background(), "5")
+	jiraErr, ok := err.(*JiraError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Empty(t, jiraErr.Messages)
+}
+
+func TestWithAutoAPIVersion(t *testing.T) {
+	for deploymentType, version := range map[string]string{"Cloud": "3", "Server": "2"} {
+		client, mux, _, teardown := setup()
+
+		probes := 0
+		mux.HandleFunc("/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+			probes++
+			fmt.Fprintf(w, `{"deploymentType": "%s"}`, deploymentType)
+		})
+		mux.HandleFunc("/api/"+version+"/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"id": "10000","key": "MCP-1"}`)
+		})
+		mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"id": 5}`)
+		})
+
+		WithAutoAPIVersion()(client)
+
+		issue, _, err := client.Issues.GetIssue(context.Background(), "MCP-1", nil)
+		assert.Nil(t, err, deploymentType)
+		assert.Equal(t, "MCP-1", issue.Key, deploymentType)
+
+		_, _, err = client.Issues.GetIssue(context.Background(), "MCP-1", nil)
+		assert.Nil(t, err, deploymentType)
+
+		_, _, err = client.Epics.Get(context.Background(), "5")
+		assert.Nil(t, err, deploymentType)
+
+		assert.Equal(t, 1, probes, deploymentType)
+		assert.Equal(t, version, client.version(), deploymentType)
+
+		teardown()
+	}
+}
+
+func TestWithAutoAPIVersionIsCloudFirst(t *testing.T) {
+	for _, first := range []string{"IsCloud", "SearchIssues"} {
+		client, mux, _, teardown := setup()
+
+		mux.HandleFunc("/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"deploymentType": "Cloud"}`)
+		})
+		mux.HandleFunc("/api/3/search/jql", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, `{"issues": [{"id": "1","key": "MCP-1"}]}`)
+		})
+
+		WithAutoAPIVersion()(client)
+
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			if first == "IsCloud" {
+				cloud, err := client.IsCloud(context.Background())
+				assert.Nil(t, err, first)
+				assert.True(t, cloud, first)
+			}
+
+			issues, _, err := client.Search.SearchIssues(context.Background(), "project = MCP", nil)
+			assert.Nil(t, err, first)
+			assert.Len(t, issues, 1, first)
+		}()
+
+		select {
+		case <-done:
+			assert.Equal(t, "3", client.version(), first)
+		case <-time.After(5 * time.Second):
+			t.Fatalf("%s first: the client is deadlocked", first)
+		}
+
+		teardown()
+	}
+}
+
+func TestWithAutoAPIVersionOverridden(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("server info requested")
+	})
+	mux.HandleFunc("/api/2/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key": "MCP-1"}`)
+	})
+
+	WithAutoAPIVersion()(client)
+	WithAPIVersion("2")(client)
+
+	_, _, err := client.Issues.GetIssue(context.Background(), "MCP-1", nil)
+	assert.Nil(t, err)
+}
+
+func TestWithAutoAPIVersionError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	WithAutoAPIVersion()(client)
+
+	_, _, err := client.Issues.GetIssue(context.Background(), "MCP-1", nil)
+	jiraErr, ok := err.(*JiraError)
+	assert.True(t, ok)
+	assert.Equal(t, http.StatusUnauthorized, jiraErr.StatusCode)
+}
+
+func TestWithDefaultPageSize(t *testing.T) {
+	c, err := NewClient(defaultBaseURL, nil, WithDefaultPageSize(25))
+	assert.Nil(t, err)
+	assert.Equal(t, 25, c.pageSize(0))
+	assert.Equal(t, 10, c.pageSize(10))
+
+	c, err = NewClient(defaultBaseURL, nil, WithDefaultPageSize(100))
+	assert.Nil(t, err)
+	assert.Equal(t, 100, c.pageSize(0))
+
+	_, err = NewClient(defaultBaseURL, nil, WithDefaultPageSize(500))
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "at most 100")
+	}
+
+	_, err = NewClient(defaultBaseURL, nil, WithDefaultPageSize(0))
+	assert.NotNil(t, err)
+}
+
+func TestWithDefaultPageSizeAutoPagination(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var maxResults []string
+	mux.HandleFunc("/epic/MCP-1/issue", func(w http.ResponseWriter, r *http.Request) {
+		maxResults = append(maxResults, r.URL.Query().Get("maxResults"))
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 25,"isLast": true,"issues": [{"key": "MCP-2"}]}`)
+	})
+
+	WithDefaultPageSize(25)(client)
+
+	_, _, err := client.Epics.ListIssuesAll(context.Background(), "MCP-1", nil)
+	assert.Nil(t, err)
+	_, _, err = client.Epics.ListIssuesAll(context.Background(), "MCP-1", &IssuesOptions{MaxResults: 5})
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{"25", "5"}, maxResults)
+}
+
+func TestWithRetainResponseBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	body := `{"id": 5,"key": "MCP-5","name": "epic name","unmapped": true}`
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, body)
+	})
+	mux.HandleFunc("/epic/6", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages": ["Issue does not exist"]}`)
+	})
+
+	_, resp, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Nil(t, resp.RawBody)
+
+	WithRetainResponseBody()(client)
+	epic, resp, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-5", epic.Key)
+	assert.Equal(t, body, string(resp.RawBody))
+
+	_, resp, err = client.Epics.Get(context.Background(), "6")
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"Issue does not exist"}, err.(*JiraError).Messages)
+	assert.Equal(t, `{"errorMessages": ["Issue does not exist"]}`, string(resp.RawBody))
+
+	WithMaxResponseBytes(10)(client)
+	_, _, err = client.Epics.Get(context.Background(), "5")
+	assert.Equal(t, ErrResponseTooLarge, err)
+}
+
+// issueV3AsJSON is an issue returned by the version 3 of the API, whose rich text fields are ADF documents
+var issueV3AsJSON = fmt.Sprintf(`{
+	"id": "10000",
+	"key": "MCP-1",
+	"fields": {
+		"summary": "Summary",
+		"description": %[1]s,
+		"environment": %[1]s,
+		"comment": {"startAt": 0, "maxResults": 1, "total": 1, "comments": [{"id": "1", "body": %[1]s}]},
+		"worklog": {"startAt": 0, "maxResults": 1, "total": 1, "worklogs": [{"id": "100", "comment": %[1]s, "timeSpent": "2h"}]}
+	}
+}`, worklogADFComment)
+
+func TestWithAutoAPIVersionCloudPayloads(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"deploymentType": "Cloud"}`)
+	})
+	mux.HandleFunc("/api/3/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, issueV3AsJSON)
+	})
+	mux.HandleFunc("/api/3/search", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"startAt": 0, "maxResults": 50, "total": 1, "issues": [%s]}`, issueV3AsJSON)
+	})
+	mux.HandleFunc("/api/3/issue/MCP-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"startAt": 0, "maxResults": 1, "total": 1, "worklogs": [{"id": "100", "comment": %s}]}`, worklogADFComment)
+	})
+
+	WithAutoAPIVersion()(client)
+	doc := TextToADF("code review")
+
+	issue, _, err := client.Issues.GetIssue(context.Background(), "MCP-1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, doc, issue.Fields.DescriptionDocument)
+	assert.Equal(t, doc, issue.Fields.EnvironmentDocument)
+	assert.Equal(t, doc, issue.Fields.Comments.Comments[0].Document)
+	assert.Equal(t, doc, issue.Fields.Worklogs.Worklogs[0].CommentDocument)
+
+	result, _, err := client.Search.Search(context.Background(), "project = MCP", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, doc, result.Issues[0].Fields.EnvironmentDocument)
+
+	worklogs, _, err := client.Worklogs.List(context.Background(), "MCP-1", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, doc, worklogs[0].CommentDocument)
+}
+
+func TestWithAutoAPIVersionCloudRichTextFields(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"deploymentType": "Cloud"}`)
+	})
+	var bodies []string
+	record := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		fmt.Fprint(w, `{"id": "10000", "key": "MCP-1"}`)
+	}
+	mux.HandleFunc("/api/3/issue", record)
+	mux.HandleFunc("/api/3/issue/MCP-1", record)
+	mux.HandleFunc("/api/3/issue/MCP-1/transitions", record)
+
+	WithAutoAPIVersion()(client)
+	adf := `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"code review"}]}]}`
+
+	issue := NewIssueRequest("MCP", "Bug", "Summary").SetField("description", "code review").SetField("environment", TextToADF("code review"))
+	_, _, err := client.Issues.Create(context.Background(), issue)
+	assert.Nil(t, err)
+	assert.Equal(t, "code review", issue.Fields["description"])
+
+	update := (&IssueUpdate{}).SetField("environment", "code review").AddOperation("description", "set", "code review").AddOperation("labels", "add", "triaged")
+	_, err = client.Issues.Update(context.Background(), "MCP-1", update, nil)
+	assert.Nil(t, err)
+
+	_, err = client.Issues.DoTransition(context.Background(), "MCP-1", "5", map[string]interface{}{"description": "code review"})
+	assert.Nil(t, err)
+
+	assert.Len(t, bodies, 3)
+	assert.JSONEq(t, `{"fields":{"project":{"key":"MCP"},"issuetype":{"name":"Bug"},"summary":"Summary","description":`+adf+`,"environment":`+adf+`}}`, bodies[0])
+	assert.JSONEq(t, `{"fields":{"environment":`+adf+`},"update":{"description":[{"set":`+adf+`}],"labels":[{"add":"triaged"}]}}`, bodies[1])
+	assert.JSONEq(t, `{"transition":{"id":"5"},"fields":{"description":`+adf+`}}`, bodies[2])
+}
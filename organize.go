@@ -0,0 +1,85 @@
+package jira
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// organizeRollbackTimeout bounds the rollback of IssuesService.Organize, which does not use the
+// context of the call, e.g. canceled, see detachedContext
+const organizeRollbackTimeout = 30 * time.Second
+
+// OrganizeResult reports the steps completed by IssuesService.Organize
+type OrganizeResult struct {
+	// PreviousEpic is the key of the epic of the issue before it was organized, empty if none
+	PreviousEpic string
+	// MovedToEpic reports whether the issue was moved to the epic, Jira responding 204 No Content
+	MovedToEpic bool
+	// MovedToSprint reports whether the issue was moved to the sprint, Jira responding 204 No Content
+	MovedToSprint bool
+	// RolledBack reports whether the issue was moved back to its previous epic, or out of any
+	// epic, after the move to the sprint failed
+	RolledBack bool
+	// RollbackErr is the error of the rollback, if it failed
+	RollbackErr error
+}
+
+// Organize moves an issue, for a given issue key, to an epic, for a given epic Id or key, then
+// to a sprint, for a given sprint Id, with EpicsService.MoveIssuesTo and SprintsService.MoveIssuesTo.
+// If the move to the sprint fails, the issue is moved back to its previous epic, on a best-effort
+// basis, and the error of the move to the sprint is returned. The rollback is sent with a context
+// of its own, keeping the values of ctx but bounded by a 30 seconds timeout rather than by ctx,
+// so that it is sent even when the move to the sprint failed because ctx was canceled. The result
+// reports the steps completed, the returned response is the one of the last step.
+//
+// POST /rest/agile/1.0/epic/{epicIdOrKey}/issue
+// POST /rest/agile/1.0/sprint/{sprintId}/issue
+func (i *IssuesService) Organize(ctx context.Context, issueKey string, epicKey string, sprintID int) (*OrganizeResult, *Response, error) {
+	result := &OrganizeResult{}
+
+	issue, resp, err := i.Get(ctx, issueKey, &GetIssueOptions{Fields: "epic"})
+	if err != nil {
+		return result, resp, err
+	}
+	if issue.Fields != nil && issue.Fields.Epic != nil {
+		result.PreviousEpic = issue.Fields.Epic.Key
+		if result.PreviousEpic == "" {
+			result.PreviousEpic = strconv.Itoa(issue.Fields.Epic.ID)
+		}
+	}
+
+	keys := &IssueKeys{Issues: []string{issueKey}}
+	if result.MovedToEpic, resp, err = i.client.Epics.MoveIssuesTo(ctx, epicKey, keys); err != nil {
+		return result, resp, err
+	}
+
+	if result.MovedToSprint, resp, err = i.client.Sprints.MoveIssuesTo(ctx, sprintID, keys); err != nil {
+		if result.MovedToEpic {
+			previous := result.PreviousEpic
+			if previous == "" {
+				previous = "none"
+			}
+
+			rctx, cancel := context.WithTimeout(detachedContext{ctx}, organizeRollbackTimeout)
+			result.RolledBack, _, result.RollbackErr = i.client.Epics.MoveIssuesTo(rctx, previous, keys)
+			cancel()
+		}
+		return result, resp, err
+	}
+
+	return result, resp, nil
+}
+
+// detachedContext is a context with the values of its parent, but never canceled
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.parent.Value(key)
+}
@@ -0,0 +1,163 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuesServiceOrganize(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "epic", r.URL.Query().Get("fields"))
+		fmt.Fprint(w, `{"key":"MCP-1","fields":{"epic":{"id":10,"key":"MCP-10"}}}`)
+	})
+	mux.HandleFunc("/epic/MCP-20/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/sprint/5/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	result, resp, err := client.Issues.Organize(context.Background(), "MCP-1", "MCP-20", 5)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Equal(t, &OrganizeResult{PreviousEpic: "MCP-10", MovedToEpic: true, MovedToSprint: true}, result)
+}
+
+func TestIssuesServiceOrganizeRollback(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key":"MCP-1","fields":{"epic":{"id":10,"key":"MCP-10"}}}`)
+	})
+	var moves []string
+	mux.HandleFunc("/epic/", func(w http.ResponseWriter, r *http.Request) {
+		var keys IssueKeys
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&keys))
+		assert.Equal(t, []string{"MCP-1"}, keys.Issues)
+		moves = append(moves, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/sprint/5/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errorMessages":["sprint is closed"]}`)
+	})
+
+	result, resp, err := client.Issues.Organize(context.Background(), "MCP-1", "MCP-20", 5)
+	assert.NotNil(t, err)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Equal(t, []string{"/epic/MCP-20/issue", "/epic/MCP-10/issue"}, moves)
+	assert.True(t, result.MovedToEpic)
+	assert.False(t, result.MovedToSprint)
+	assert.True(t, result.RolledBack)
+	assert.Nil(t, result.RollbackErr)
+}
+
+func TestIssuesServiceOrganizeRollbackWithoutEpic(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key":"MCP-1","fields":{}}`)
+	})
+	mux.HandleFunc("/epic/MCP-20/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/epic/none/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	})
+	mux.HandleFunc("/sprint/5/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+	})
+
+	result, _, err := client.Issues.Organize(context.Background(), "MCP-1", "MCP-20", 5)
+	assert.NotNil(t, err)
+	assert.Equal(t, "", result.PreviousEpic)
+	assert.True(t, result.MovedToEpic)
+	assert.False(t, result.RolledBack)
+	assert.NotNil(t, result.RollbackErr)
+}
+
+func TestIssuesServiceOrganizeEpicFailure(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key":"MCP-1","fields":{}}`)
+	})
+	mux.HandleFunc("/epic/MCP-20/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+	mux.HandleFunc("/sprint/5/issue", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("sprint move must not be called")
+	})
+
+	result, _, err := client.Issues.Organize(context.Background(), "MCP-1", "MCP-20", 5)
+	assert.NotNil(t, err)
+	assert.Equal(t, &OrganizeResult{}, result)
+}
+
+func TestIssuesServiceOrganizeNotMoved(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key":"MCP-1","fields":{}}`)
+	})
+	mux.HandleFunc("/epic/MCP-20/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mux.HandleFunc("/sprint/5/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	result, _, err := client.Issues.Organize(context.Background(), "MCP-1", "MCP-20", 5)
+	assert.Nil(t, err)
+	assert.False(t, result.MovedToEpic)
+	assert.False(t, result.MovedToSprint)
+}
+
+func TestIssuesServiceOrganizeRollbackCanceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mux.HandleFunc("/issue/MCP-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"key":"MCP-1","fields":{"epic":{"id":10,"key":"MCP-10"}}}`)
+	})
+	mux.HandleFunc("/epic/MCP-20/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	})
+	rolledBack := false
+	mux.HandleFunc("/epic/MCP-10/issue", func(w http.ResponseWriter, r *http.Request) {
+		rolledBack = true
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/sprint/5/issue", func(w http.ResponseWriter, r *http.Request) {
+		cancel()
+		time.Sleep(50 * time.Millisecond)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	result, _, err := client.Issues.Organize(ctx, "MCP-1", "MCP-20", 5)
+	assert.Equal(t, context.Canceled, err)
+	assert.True(t, result.MovedToEpic)
+	assert.False(t, result.MovedToSprint)
+	assert.True(t, rolledBack)
+	assert.True(t, result.RolledBack)
+	assert.Nil(t, result.RollbackErr)
+}
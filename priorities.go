@@ -0,0 +1,50 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// PrioritiesService handles communication with the priority related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/priority
+type PrioritiesService service
+
+// List returns all issue priorities.
+//
+// GET /rest/api/2/priority
+func (p *PrioritiesService) List(ctx context.Context) ([]*IssuePriority, *Response, error) {
+
+	req, err := p.client.NewRequest("GET", p.client.apiPath("priority"), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var priorities []*IssuePriority
+	resp, err := p.client.Do(ctx, req, &priorities)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return priorities, resp, nil
+}
+
+// Get returns a priority, for a given priority Id.
+//
+// GET /rest/api/2/priority/{id}
+func (p *PrioritiesService) Get(ctx context.Context, id string) (*IssuePriority, *Response, error) {
+
+	req, err := p.client.NewRequest("GET", p.client.apiPath(fmt.Sprintf("priority/%s", id)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var priority = &IssuePriority{}
+	resp, err := p.client.Do(ctx, req, priority)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return priority, resp, nil
+}
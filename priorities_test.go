@@ -0,0 +1,43 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrioritiesServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/priority", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `[{"id": "1","name": "Highest","description": "This problem will block progress.","iconUrl": "https://jira.mycompany.com/images/icons/priorities/highest.svg"},{"id": "5","name": "Lowest","description": "Trivial problem with little or no impact on progress."}]`)
+	})
+
+	priorities, _, err := client.Priorities.List(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, priorities, 2)
+	assert.Equal(t, "Highest", priorities[0].Name)
+	assert.Equal(t, "This problem will block progress.", priorities[0].Description)
+	assert.Equal(t, "Lowest", priorities[1].Name)
+}
+
+func TestPrioritiesServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/priority/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": "1","name": "Highest","description": "This problem will block progress.","iconUrl": "https://jira.mycompany.com/images/icons/priorities/highest.svg"}`)
+	})
+
+	priority, _, err := client.Priorities.Get(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", priority.ID)
+	assert.Equal(t, "Highest", priority.Name)
+	assert.Equal(t, "https://jira.mycompany.com/images/icons/priorities/highest.svg", priority.IconURL)
+}
@@ -25,6 +25,11 @@ type Project struct {
 	Category   ProjectCategory   `json:"projectCategory,omitempty"`
 	Simplified string            `json:"simplified,omitempty"`
 	Style      string            `json:"style,omitempty"`
+	// The fields below are only returned by ProjectsService
+	Description    string       `json:"description,omitempty"`
+	ProjectTypeKey string       `json:"projectTypeKey,omitempty"`
+	Lead           *IssueUser   `json:"lead,omitempty"`
+	IssueTypes     []*IssueType `json:"issueTypes,omitempty"`
 }
 
 // ProjectsOptions contains all options to get a project from a board
@@ -0,0 +1,117 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// ProjectsService handles communication with the project related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/project
+type ProjectsService service
+
+// ProjectSearchWrap represents the data returned by the project search,
+// in addition to the project information, paging data is returned
+type ProjectSearchWrap struct {
+	Pagination
+	Values []*Project `json:"values,omitempty"`
+}
+
+// ProjectSearchOptions contains all options to search for projects
+type ProjectSearchOptions struct {
+	//The index of the first project to return (0-based)
+	StartAt int `query:"startAt"`
+	//The maximum number of projects to return per page. Default: 50.
+	MaxResults int `query:"maxResults"`
+	//Filters the results using a literal string. Projects with a matching key or name are returned (case insensitive).
+	Query string `query:"query"`
+	//Orders the results by a field, e.g. key, name, category. Prefix with - to sort in descending order.
+	OrderBy string `query:"orderBy"`
+	//Expands additional information in the response, e.g. lead, issueTypes, description.
+	Expand []string `query:"expand"`
+}
+
+// ProjectOptions contains all options to get a project
+type ProjectOptions struct {
+	//Expands additional information in the response, e.g. lead, issueTypes, description.
+	Expand []string `query:"expand"`
+}
+
+// ProjectIssueTypeStatuses represents the statuses that can be used by an issue type of a project
+type ProjectIssueTypeStatuses struct {
+	ID       string         `json:"id,omitempty"`
+	Name     string         `json:"name,omitempty"`
+	SelfLink string         `json:"self,omitempty"`
+	SubTask  bool           `json:"subtask,omitempty"`
+	Statuses []*IssueStatus `json:"statuses,omitempty"`
+}
+
+// List returns the projects visible to the user, a page at a time.
+//
+// GET /rest/api/2/project/search
+func (p *ProjectsService) List(ctx context.Context, opts *ProjectSearchOptions) ([]*Project, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := p.client.NewRequest("GET", p.client.apiPath(fmt.Sprintf("project/search%s", q)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &ProjectSearchWrap{}
+	resp, err := p.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.MaxResults = wrap.MaxResults
+	resp.StartAt = wrap.StartAt
+	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
+
+	return wrap.Values, resp, nil
+}
+
+// Get returns a project, for a given project Id or project key. The lead
+// and the issue types are only returned when requested in opts.Expand.
+//
+// GET /rest/api/2/project/{projectIdOrKey}
+func (p *ProjectsService) Get(ctx context.Context, projectIDOrKey string, opts *ProjectOptions) (*Project, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := p.client.NewRequest("GET", p.client.apiPath(fmt.Sprintf("project/%s%s", projectIDOrKey, q)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var project = &Project{}
+	resp, err := p.client.Do(ctx, req, project)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return project, resp, nil
+}
+
+// GetStatuses returns the issue types of a project, for a given project Id or project key,
+// each one with the statuses its issues can have.
+//
+// GET /rest/api/2/project/{projectIdOrKey}/statuses
+func (p *ProjectsService) GetStatuses(ctx context.Context, projectIDOrKey string) ([]*ProjectIssueTypeStatuses, *Response, error) {
+
+	req, err := p.client.NewRequest("GET", p.client.apiPath(fmt.Sprintf("project/%s/statuses", projectIDOrKey)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var statuses []*ProjectIssueTypeStatuses
+	resp, err := p.client.Do(ctx, req, &statuses)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return statuses, resp, nil
+}
@@ -0,0 +1,67 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProjectsServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/project/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "mcp", r.URL.Query().Get("query"))
+		assert.Equal(t, "lead", r.URL.Query().Get("expand"))
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 50,"total": 1,"isLast": true,"values": [{"id": "10000","key": "MCP","name": "My Cool Project","projectTypeKey": "software","lead": {"accountId": "5b10a2844c20165700ede21g"}}]}`)
+	})
+
+	opts := &ProjectSearchOptions{Query: "mcp", Expand: []string{"lead"}}
+	projects, resp, err := client.Projects.List(context.Background(), opts)
+	assert.Nil(t, err)
+	assert.Len(t, projects, 1)
+	assert.Equal(t, "MCP", projects[0].Key)
+	assert.Equal(t, "software", projects[0].ProjectTypeKey)
+	assert.Equal(t, "5b10a2844c20165700ede21g", projects[0].Lead.AccountID)
+	assert.True(t, resp.IsLast)
+	assert.Equal(t, 50, resp.MaxResults)
+}
+
+func TestProjectsServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/project/MCP", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "lead,issueTypes", r.URL.Query().Get("expand"))
+		fmt.Fprint(w, `{"id": "10000","key": "MCP","name": "My Cool Project","issueTypes": [{"id": "10001","name": "Story"},{"id": "10002","name": "Sub-task","subtask": true}]}`)
+	})
+
+	project, _, err := client.Projects.Get(context.Background(), "MCP", &ProjectOptions{Expand: []string{"lead", "issueTypes"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "10000", project.ID)
+	assert.Len(t, project.IssueTypes, 2)
+	assert.Equal(t, "Story", project.IssueTypes[0].Name)
+	assert.True(t, project.IssueTypes[1].SubTask)
+}
+
+func TestProjectsServiceGetStatuses(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/project/MCP/statuses", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `[{"id": "10001","name": "Story","subtask": false,"statuses": [{"id": "1","name": "To Do"},{"id": "3","name": "In Progress"}]}]`)
+	})
+
+	types, _, err := client.Projects.GetStatuses(context.Background(), "MCP")
+	assert.Nil(t, err)
+	assert.Len(t, types, 1)
+	assert.Equal(t, "Story", types[0].Name)
+	assert.Len(t, types[0].Statuses, 2)
+	assert.Equal(t, "In Progress", types[0].Statuses[1].Name)
+}
@@ -0,0 +1,119 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// EntityProperty represents a property of an entity, e.g. an issue, holding any JSON value
+type EntityProperty struct {
+	Key   string          `json:"key,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// EntityPropertyKey represents the key of a property of an entity, see EntityProperty
+type EntityPropertyKey struct {
+	Key      string `json:"key,omitempty"`
+	SelfLink string `json:"self,omitempty"`
+}
+
+// EntityPropertyKeyWrap represents the keys of the properties of an entity
+type EntityPropertyKeyWrap struct {
+	Keys []*EntityPropertyKey `json:"keys,omitempty"`
+}
+
+// IssuePropertiesService handles communication with the issue property related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/issue-getPropertiesKeys
+type IssuePropertiesService service
+
+// List returns the keys of the properties of an issue, for a given issue Id or issue key.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/properties
+func (i *IssuePropertiesService) List(ctx context.Context, issueIDOrKey string) ([]*EntityPropertyKey, *Response, error) {
+	return i.client.listProperties(ctx, i.client.apiPath(fmt.Sprintf("issue/%s/properties", issueIDOrKey)))
+}
+
+// Get returns a property of an issue, for a given issue Id or issue key and property key.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/properties/{propertyKey}
+func (i *IssuePropertiesService) Get(ctx context.Context, issueIDOrKey string, key string) (*EntityProperty, *Response, error) {
+	return i.client.getProperty(ctx, i.client.apiPath(fmt.Sprintf("issue/%s/properties/%s", issueIDOrKey, url.PathEscape(key))))
+}
+
+// Set sets the value of a property of an issue, for a given issue Id or issue key and property key.
+// The value is encoded to JSON, a json.RawMessage is sent as is. It returns whether the property
+// was created (201), rather than updated (200).
+//
+// PUT /rest/api/2/issue/{issueIdOrKey}/properties/{propertyKey}
+func (i *IssuePropertiesService) Set(ctx context.Context, issueIDOrKey string, key string, value interface{}) (bool, *Response, error) {
+	return i.client.setProperty(ctx, i.client.apiPath(fmt.Sprintf("issue/%s/properties/%s", issueIDOrKey, url.PathEscape(key))), value)
+}
+
+// Delete deletes a property of an issue, for a given issue Id or issue key and property key.
+//
+// DELETE /rest/api/2/issue/{issueIdOrKey}/properties/{propertyKey}
+func (i *IssuePropertiesService) Delete(ctx context.Context, issueIDOrKey string, key string) (*Response, error) {
+	return i.client.deleteProperty(ctx, i.client.apiPath(fmt.Sprintf("issue/%s/properties/%s", issueIDOrKey, url.PathEscape(key))))
+}
+
+// listProperties returns the keys of the properties at the given path
+func (c *Client) listProperties(ctx context.Context, path string) ([]*EntityPropertyKey, *Response, error) {
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &EntityPropertyKeyWrap{}
+	resp, err := c.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return wrap.Keys, resp, nil
+}
+
+// getProperty returns the property at the given path
+func (c *Client) getProperty(ctx context.Context, path string) (*EntityProperty, *Response, error) {
+	req, err := c.NewRequest("GET", path, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var property = &EntityProperty{}
+	resp, err := c.Do(ctx, req, property)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return property, resp, nil
+}
+
+// setProperty sets the value of the property at the given path, reporting whether it was created
+func (c *Client) setProperty(ctx context.Context, path string, value interface{}) (bool, *Response, error) {
+	req, err := c.NewRequest("PUT", path, value)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := c.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	return resp.StatusCode == http.StatusCreated, resp, nil
+}
+
+// deleteProperty deletes the property at the given path
+func (c *Client) deleteProperty(ctx context.Context, path string) (*Response, error) {
+	req, err := c.NewRequest("DELETE", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(ctx, req, nil)
+}
@@ -0,0 +1,82 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIssuePropertiesServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/properties", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"keys": [{"self": "https://jira.mycompany.com/rest/api/2/issue/MCP-1/properties/issue.support","key": "issue.support"}]}`)
+	})
+
+	keys, _, err := client.IssueProperties.List(context.Background(), "MCP-1")
+	assert.Nil(t, err)
+	assert.Len(t, keys, 1)
+	assert.Equal(t, "issue.support", keys[0].Key)
+}
+
+func TestIssuePropertiesServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/properties/issue.support", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"key": "issue.support","value": {"hipchat.room.id": "support-123","support.time": "1m"}}`)
+	})
+
+	property, _, err := client.IssueProperties.Get(context.Background(), "MCP-1", "issue.support")
+	assert.Nil(t, err)
+	assert.Equal(t, "issue.support", property.Key)
+	assert.JSONEq(t, `{"hipchat.room.id": "support-123","support.time": "1m"}`, string(property.Value))
+}
+
+func TestIssuePropertiesServiceSet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	created := true
+	mux.HandleFunc("/api/2/issue/MCP-1/properties/issue.support", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"support.time": "1m"}`, string(body))
+		if created {
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+
+	ok, _, err := client.IssueProperties.Set(context.Background(), "MCP-1", "issue.support", map[string]string{"support.time": "1m"})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	created = false
+	ok, resp, err := client.IssueProperties.Set(context.Background(), "MCP-1", "issue.support", map[string]string{"support.time": "1m"})
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestIssuePropertiesServiceDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/properties/issue.support", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.IssueProperties.Delete(context.Background(), "MCP-1", "issue.support")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
@@ -0,0 +1,18 @@
+package jira
+
+import "context"
+
+// RateLimiter throttles the requests sent by the client, see WithRateLimiter. It is
+// implemented by *rate.Limiter of golang.org/x/time/rate.
+type RateLimiter interface {
+	// Wait blocks until a request can be sent or ctx is done, in which case it returns an error
+	Wait(ctx context.Context) error
+}
+
+// waitRateLimit waits for the rate limiter defined by WithRateLimiter, if any
+func (c *Client) waitRateLimit(ctx context.Context) error {
+	if c.rateLimiter == nil {
+		return nil
+	}
+	return c.rateLimiter.Wait(ctx)
+}
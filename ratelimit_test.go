@@ -0,0 +1,66 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type countingLimiter struct {
+	waits int
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.waits++
+	return ctx.Err()
+}
+
+func TestDoRateLimiterRetries(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	limiter := &countingLimiter{}
+	WithRateLimiter(limiter)(client)
+	WithRetry(3, time.Millisecond)(client)
+
+	calls := 0
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"id": 5,"key": "MCP-5"}`)
+	})
+
+	epic, resp, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-5", epic.Key)
+	assert.Equal(t, 3, resp.Attempts)
+	assert.Equal(t, 3, limiter.waits)
+}
+
+func TestDoRateLimiterCanceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	limiter := &countingLimiter{}
+	WithRateLimiter(limiter)(client)
+
+	calls := 0
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, _, err := client.Epics.Get(ctx, "5")
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 1, limiter.waits)
+	assert.Equal(t, 0, calls)
+}
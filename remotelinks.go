@@ -0,0 +1,135 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// RemoteLinksService handles communication with the remote issue link related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/issue-getRemoteIssueLinks
+type RemoteLinksService service
+
+// RemoteLink represents a link from an issue to an object in a remote system, e.g. a build
+type RemoteLink struct {
+	ID       int    `json:"id,omitempty"`
+	SelfLink string `json:"self,omitempty"`
+	// GlobalID identifies the remote object. Creating a link with the GlobalID of an
+	// existing link of the issue updates that link.
+	GlobalID     string                 `json:"globalId,omitempty"`
+	Application  *RemoteLinkApplication `json:"application,omitempty"`
+	Relationship string                 `json:"relationship,omitempty"`
+	Object       *RemoteLinkObject      `json:"object,omitempty"`
+}
+
+// RemoteLinkApplication represents the application the remote object belongs to
+type RemoteLinkApplication struct {
+	Type string `json:"type,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// RemoteLinkObject represents the remote object
+type RemoteLinkObject struct {
+	URL     string            `json:"url,omitempty"`
+	Title   string            `json:"title,omitempty"`
+	Summary string            `json:"summary,omitempty"`
+	Icon    *RemoteLinkIcon   `json:"icon,omitempty"`
+	Status  *RemoteLinkStatus `json:"status,omitempty"`
+}
+
+// RemoteLinkIcon represents the icon of a remote object or status
+type RemoteLinkIcon struct {
+	URL   string `json:"url16x16,omitempty"`
+	Title string `json:"title,omitempty"`
+	Link  string `json:"link,omitempty"`
+}
+
+// RemoteLinkStatus represents the status of the remote object
+type RemoteLinkStatus struct {
+	Resolved bool            `json:"resolved,omitempty"`
+	Icon     *RemoteLinkIcon `json:"icon,omitempty"`
+}
+
+// List returns the remote links of an issue, for a given issue Id or issue key. If
+// globalID is not empty, only the link with that global id is returned.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/remotelink
+func (r *RemoteLinksService) List(ctx context.Context, issueIDOrKey string, globalID string) ([]*RemoteLink, *Response, error) {
+
+	u := fmt.Sprintf("issue/%s/remotelink", issueIDOrKey)
+	if globalID != "" {
+		u += "?globalId=" + url.QueryEscape(globalID)
+	}
+
+	req, err := r.client.NewRequest("GET", r.client.apiPath(u), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var links []*RemoteLink
+	if globalID != "" {
+		// a single link is returned when filtering by global id
+		var link = &RemoteLink{}
+		resp, err := r.client.Do(ctx, req, link)
+		if err != nil {
+			return nil, resp, err
+		}
+		return append(links, link), resp, nil
+	}
+
+	resp, err := r.client.Do(ctx, req, &links)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return links, resp, nil
+}
+
+// Create creates a remote link of an issue, for a given issue Id or issue key. If the
+// issue already has a link with the same GlobalID, that link is updated instead. It
+// returns the link with the ID and SelfLink set.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/remotelink
+func (r *RemoteLinksService) Create(ctx context.Context, issueIDOrKey string, link *RemoteLink) (*RemoteLink, *Response, error) {
+
+	req, err := r.client.NewRequest("POST", r.client.apiPath(fmt.Sprintf("issue/%s/remotelink", issueIDOrKey)), link)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created = &RemoteLink{}
+	resp, err := r.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// Update replaces a remote link of an issue, for a given issue Id or issue key and link Id.
+//
+// PUT /rest/api/2/issue/{issueIdOrKey}/remotelink/{linkId}
+func (r *RemoteLinksService) Update(ctx context.Context, issueIDOrKey string, linkID int, link *RemoteLink) (*Response, error) {
+
+	req, err := r.client.NewRequest("PUT", r.client.apiPath(fmt.Sprintf("issue/%s/remotelink/%d", issueIDOrKey, linkID)), link)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.client.Do(ctx, req, nil)
+}
+
+// Delete deletes a remote link of an issue, for a given issue Id or issue key and link Id.
+//
+// DELETE /rest/api/2/issue/{issueIdOrKey}/remotelink/{linkId}
+func (r *RemoteLinksService) Delete(ctx context.Context, issueIDOrKey string, linkID int) (*Response, error) {
+
+	req, err := r.client.NewRequest("DELETE", r.client.apiPath(fmt.Sprintf("issue/%s/remotelink/%d", issueIDOrKey, linkID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,101 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteLinksServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `[{"id": 10000,"globalId": "build=42","relationship": "built by","object": {"url": "https://ci.mycompany.com/builds/42","title": "Build 42","status": {"resolved": true}}}]`)
+	})
+
+	links, _, err := client.RemoteLinks.List(context.Background(), "MCP-1", "")
+	assert.Nil(t, err)
+	assert.Len(t, links, 1)
+	assert.Equal(t, 10000, links[0].ID)
+	assert.Equal(t, "build=42", links[0].GlobalID)
+	assert.Equal(t, "Build 42", links[0].Object.Title)
+	assert.True(t, links[0].Object.Status.Resolved)
+}
+
+func TestRemoteLinksServiceListByGlobalID(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "build=42", r.URL.Query().Get("globalId"))
+		fmt.Fprint(w, `{"id": 10000,"globalId": "build=42"}`)
+	})
+
+	links, _, err := client.RemoteLinks.List(context.Background(), "MCP-1", "build=42")
+	assert.Nil(t, err)
+	assert.Len(t, links, 1)
+	assert.Equal(t, 10000, links[0].ID)
+}
+
+func TestRemoteLinksServiceCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/remotelink", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		link := &RemoteLink{}
+		json.NewDecoder(r.Body).Decode(link)
+		assert.Equal(t, "build=42", link.GlobalID)
+		assert.Equal(t, "CI", link.Application.Name)
+		assert.Equal(t, "https://ci.mycompany.com/builds/42", link.Object.URL)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": 10000,"self": "https://jira.mycompany.com/rest/api/2/issue/MCP-1/remotelink/10000"}`)
+	})
+
+	link := &RemoteLink{
+		GlobalID:    "build=42",
+		Application: &RemoteLinkApplication{Name: "CI"},
+		Object:      &RemoteLinkObject{URL: "https://ci.mycompany.com/builds/42", Title: "Build 42"},
+	}
+	created, _, err := client.RemoteLinks.Create(context.Background(), "MCP-1", link)
+	assert.Nil(t, err)
+	assert.Equal(t, 10000, created.ID)
+	assert.Equal(t, "https://jira.mycompany.com/rest/api/2/issue/MCP-1/remotelink/10000", created.SelfLink)
+}
+
+func TestRemoteLinksServiceUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/remotelink/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	link := &RemoteLink{Object: &RemoteLinkObject{URL: "https://ci.mycompany.com/builds/43", Title: "Build 43"}}
+	resp, err := client.RemoteLinks.Update(context.Background(), "MCP-1", 10000, link)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestRemoteLinksServiceDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/remotelink/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.RemoteLinks.Delete(context.Background(), "MCP-1", 10000)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
@@ -0,0 +1,50 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// ResolutionsService handles communication with the resolution related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/resolution
+type ResolutionsService service
+
+// List returns all issue resolutions.
+//
+// GET /rest/api/2/resolution
+func (r *ResolutionsService) List(ctx context.Context) ([]*IssueResolution, *Response, error) {
+
+	req, err := r.client.NewRequest("GET", r.client.apiPath("resolution"), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resolutions []*IssueResolution
+	resp, err := r.client.Do(ctx, req, &resolutions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resolutions, resp, nil
+}
+
+// Get returns a resolution, for a given resolution Id.
+//
+// GET /rest/api/2/resolution/{id}
+func (r *ResolutionsService) Get(ctx context.Context, id string) (*IssueResolution, *Response, error) {
+
+	req, err := r.client.NewRequest("GET", r.client.apiPath(fmt.Sprintf("resolution/%s", id)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var resolution = &IssueResolution{}
+	resp, err := r.client.Do(ctx, req, resolution)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return resolution, resp, nil
+}
@@ -0,0 +1,43 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolutionsServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/resolution", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `[{"id": "1","name": "Fixed","description": "A fix for this issue is checked into the tree and tested.","iconUrl": "https://jira.mycompany.com/images/icons/resolutions/fixed.png"},{"id": "2","name": "Won't Fix","description": "The problem described is an issue which will never be fixed."}]`)
+	})
+
+	resolutions, _, err := client.Resolutions.List(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, resolutions, 2)
+	assert.Equal(t, "Fixed", resolutions[0].Name)
+	assert.Equal(t, "A fix for this issue is checked into the tree and tested.", resolutions[0].Description)
+	assert.Equal(t, "Won't Fix", resolutions[1].Name)
+}
+
+func TestResolutionsServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/resolution/1", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": "1","name": "Fixed","description": "A fix for this issue is checked into the tree and tested.","iconUrl": "https://jira.mycompany.com/images/icons/resolutions/fixed.png"}`)
+	})
+
+	resolution, _, err := client.Resolutions.Get(context.Background(), "1")
+	assert.Nil(t, err)
+	assert.Equal(t, "1", resolution.ID)
+	assert.Equal(t, "Fixed", resolution.Name)
+	assert.Equal(t, "https://jira.mycompany.com/images/icons/resolutions/fixed.png", resolution.IconURL)
+}
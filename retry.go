@@ -0,0 +1,119 @@
+package jira
+
+import (
+	"context"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+)
+
+// retryPolicy defines which failed requests are sent again and how long the
+// client waits between the attempts.
+type retryPolicy struct {
+	maxRetries int
+	baseDelay  time.Duration
+	methods    map[string]bool
+	budget     *retryBudget
+}
+
+// defaultRetryMethods contains the idempotent methods retried by default.
+var defaultRetryMethods = map[string]bool{
+	http.MethodGet:  true,
+	http.MethodHead: true,
+}
+
+// shouldRetry reports whether the request must be sent again after the given attempt.
+// Only throttled requests (429), server errors (5xx) and network timeouts are retried.
+func (p retryPolicy) shouldRetry(ctx context.Context, req *http.Request, attempt int, resp *Response, err error) bool {
+	if attempt > p.maxRetries || err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	methods := p.methods
+	if methods == nil {
+		methods = defaultRetryMethods
+	}
+	if !methods[req.Method] {
+		return false
+	}
+
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	if resp != nil {
+		return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+	}
+
+	netErr, ok := err.(net.Error)
+	return ok && netErr.Timeout()
+}
+
+// wait sleeps before the next attempt, using the delay requested by the server through
+// the Retry-After header when throttled, or an exponential backoff with jitter otherwise.
+// The context error is returned if ctx is done before the end of the delay.
+func (p retryPolicy) wait(ctx context.Context, attempt int, resp *Response) error {
+	d := p.backoff(attempt)
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests && resp.RetryAfter > 0 {
+		d = resp.RetryAfter
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// maxRetryDelay bounds the exponential backoff between the attempts
+const maxRetryDelay = time.Minute
+
+// maxRetryShift bounds the exponent of the backoff, so that the shift cannot overflow
+const maxRetryShift = 30
+
+// backoff returns the delay after the given attempt, a random duration between
+// half and the whole of baseDelay * 2^(attempt-1), bounded by maxRetryDelay.
+func (p retryPolicy) backoff(attempt int) time.Duration {
+	if p.baseDelay <= 0 {
+		return 0
+	}
+
+	shift := uint(0)
+	if attempt > 1 {
+		shift = uint(attempt - 1)
+	}
+	if shift > maxRetryShift {
+		shift = maxRetryShift
+	}
+
+	d := maxRetryDelay
+	if p.baseDelay <= maxRetryDelay>>shift {
+		d = p.baseDelay << shift
+	}
+
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// rewindBody returns a copy of the request with a fresh body, so it can be sent again.
+func rewindBody(req *http.Request) (*http.Request, error) {
+	if req.GetBody == nil || req.Body == nil || req.Body == http.NoBody {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	r := new(http.Request)
+	*r = *req
+	r.Body = body
+
+	return r, nil
+}
@@ -0,0 +1,236 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoRetryServerError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRetry(3, time.Millisecond)(client)
+
+	calls := 0
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		fmt.Fprint(w, `{"id": 5,"key": "MCP-5"}`)
+	})
+
+	epic, resp, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-5", epic.Key)
+	assert.Equal(t, 3, resp.Attempts)
+}
+
+func TestDoRetryExhausted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRetry(2, time.Millisecond)(client)
+
+	calls := 0
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "Bad Gateway", http.StatusBadGateway)
+	})
+
+	_, resp, err := client.Epics.Get(context.Background(), "5")
+	assert.NotNil(t, err)
+	assert.Equal(t, 3, calls)
+	assert.Equal(t, 3, resp.Attempts)
+}
+
+func TestDoRetryClientErrorNotRetried(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRetry(2, time.Millisecond)(client)
+
+	calls := 0
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "Not Found", http.StatusNotFound)
+	})
+
+	_, resp, err := client.Epics.Get(context.Background(), "5")
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, calls)
+	assert.Equal(t, 1, resp.Attempts)
+}
+
+func TestDoRetryPostRequiresOptIn(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRetry(2, time.Millisecond)(client)
+
+	calls := 0
+	mux.HandleFunc("/backlog/issue", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+	})
+
+	_, _, err := client.Backlog.MoveIssuesTo(context.Background(), &IssueKeys{Issues: []string{"MCP-1"}})
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, calls)
+}
+
+func TestDoRetryPostRewindsBody(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRetry(2, time.Millisecond)(client)
+	WithRetryMethods(http.MethodPost)(client)
+
+	calls := 0
+	mux.HandleFunc("/backlog/issue", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, `{"issues":["MCP-1"]}`+"\n", string(body))
+		if calls == 1 {
+			http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	ok, resp, err := client.Backlog.MoveIssuesTo(context.Background(), &IssueKeys{Issues: []string{"MCP-1"}})
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 2, resp.Attempts)
+}
+
+func TestDoRetryContextCanceled(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRetry(5, time.Minute)(client)
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, resp, err := client.Epics.Get(ctx, "5")
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 1, resp.Attempts)
+}
+
+func TestDoRetryRequestTimeout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRetry(1, time.Millisecond)(client)
+	WithRequestTimeout(20 * time.Millisecond)(client)
+
+	calls := 0
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			<-r.Context().Done()
+			return
+		}
+		fmt.Fprint(w, `{"id": 5,"key": "MCP-5"}`)
+	})
+
+	epic, resp, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, "MCP-5", epic.Key)
+	assert.Equal(t, 2, resp.Attempts)
+}
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	p := retryPolicy{baseDelay: 100 * time.Millisecond}
+
+	for attempt := 1; attempt <= 4; attempt++ {
+		max := p.baseDelay << uint(attempt-1)
+		d := p.backoff(attempt)
+		assert.True(t, d >= max/2 && d <= max, "attempt %d: %v", attempt, d)
+	}
+}
+
+func TestRetryPolicyBackoffMaxDelay(t *testing.T) {
+	for _, p := range []retryPolicy{{baseDelay: 100 * time.Millisecond}, {baseDelay: time.Hour}} {
+		for _, attempt := range []int{10, 64, 100, 1 << 20} {
+			d := p.backoff(attempt)
+			assert.True(t, d >= maxRetryDelay/2 && d <= maxRetryDelay, "%v, attempt %d: %v", p.baseDelay, attempt, d)
+		}
+	}
+}
+
+func TestDoRetryTooManyRequests(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRetry(1, time.Millisecond)(client)
+
+	calls := 0
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"id": 5,"key": "MCP-5"}`)
+	})
+
+	_, resp, err := client.Epics.Get(context.Background(), "5")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, resp.Attempts)
+}
+
+func TestRetryPolicyWaitUsesRetryAfter(t *testing.T) {
+	p := retryPolicy{baseDelay: time.Hour}
+	resp := &Response{
+		Response:   &http.Response{StatusCode: http.StatusTooManyRequests},
+		RetryAfter: time.Millisecond,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	assert.Nil(t, p.wait(ctx, 1, resp))
+}
+
+func TestDoRetryRawRequest(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRetry(2, time.Millisecond)(client)
+	WithRetryMethods(http.MethodPost)(client)
+
+	calls := 0
+	mux.HandleFunc("/foo", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, "content", string(body))
+		http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+	})
+
+	req, _ := client.NewRequestRaw("POST", "foo", struct{ io.ReadSeeker }{strings.NewReader("content")}, "text/plain")
+	_, err := client.Do(context.Background(), req, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, 3, calls)
+
+	calls = 0
+	req, _ = client.NewRequestRaw("POST", "foo", struct{ io.Reader }{strings.NewReader("content")}, "text/plain")
+	_, err = client.Do(context.Background(), req, nil)
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, calls)
+}
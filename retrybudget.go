@@ -0,0 +1,131 @@
+package jira
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// retryBudgetWindow is the sliding window over which the retry budget counts the requests
+	retryBudgetWindow = 10 * time.Second
+	// retryBudgetBuckets is the number of buckets the window is divided into
+	retryBudgetBuckets = 10
+	// minRetryBudget is the number of retries always allowed in the window, so that a client
+	// sending few requests can still retry them
+	minRetryBudget = 3
+)
+
+// RetryBudgetState reports the state of the retry budget defined by WithRetryBudget, over the
+// last 10 seconds
+type RetryBudgetState struct {
+	// Ratio is the maximum ratio of retries to requests
+	Ratio float64
+	// Requests is the number of requests sent, retries excluded
+	Requests int
+	// Retries is the number of retries sent
+	Retries int
+	// Exhausted reports whether the failed requests are no longer retried
+	Exhausted bool
+}
+
+// retryBudget counts the requests and the retries over a sliding window, divided into buckets
+// of one second
+type retryBudget struct {
+	ratio float64
+	now   func() time.Time
+
+	mu       sync.Mutex
+	buckets  [retryBudgetBuckets]retryBucket
+	requests int
+	retries  int
+}
+
+// retryBucket counts the requests and retries of a second
+type retryBucket struct {
+	second   int64
+	requests int
+	retries  int
+}
+
+func newRetryBudget(ratio float64) *retryBudget {
+	return &retryBudget{ratio: ratio, now: time.Now}
+}
+
+// bucket returns the bucket of the current second, expiring the buckets out of the window.
+// b.mu must be held.
+func (b *retryBudget) bucket() *retryBucket {
+	second := b.now().UnixNano() / int64(retryBudgetWindow/retryBudgetBuckets)
+	for i := range b.buckets {
+		if bk := &b.buckets[i]; bk.second <= second-retryBudgetBuckets && (bk.requests > 0 || bk.retries > 0) {
+			b.requests -= bk.requests
+			b.retries -= bk.retries
+			*bk = retryBucket{}
+		}
+	}
+
+	bk := &b.buckets[second%retryBudgetBuckets]
+	if bk.second != second {
+		b.requests -= bk.requests
+		b.retries -= bk.retries
+		*bk = retryBucket{second: second}
+	}
+	return bk
+}
+
+// request counts a request, its first attempt
+func (b *retryBudget) request() {
+	if b == nil {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.bucket().requests++
+	b.requests++
+}
+
+// withdraw counts a retry and reports whether the budget allows it
+func (b *retryBudget) withdraw() bool {
+	if b == nil {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	bk := b.bucket()
+	if b.exhausted() {
+		return false
+	}
+	bk.retries++
+	b.retries++
+	return true
+}
+
+// exhausted reports whether one more retry would exceed the budget. b.mu must be held.
+func (b *retryBudget) exhausted() bool {
+	allowed := int(b.ratio * float64(b.requests))
+	if allowed < minRetryBudget {
+		allowed = minRetryBudget
+	}
+	return b.retries >= allowed
+}
+
+// state returns the state of the budget
+func (b *retryBudget) state() RetryBudgetState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.bucket()
+	return RetryBudgetState{Ratio: b.ratio, Requests: b.requests, Retries: b.retries, Exhausted: b.exhausted()}
+}
+
+// RetryBudget returns the state of the retry budget defined by WithRetryBudget, for monitoring.
+// ok is false if no budget was defined.
+func (c *Client) RetryBudget() (state RetryBudgetState, ok bool) {
+	if c.retry.budget == nil {
+		return RetryBudgetState{}, false
+	}
+	return c.retry.budget.state(), true
+}
@@ -0,0 +1,79 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryBudget(t *testing.T) {
+	now := time.Unix(1000, 0)
+	b := newRetryBudget(0.5)
+	b.now = func() time.Time { return now }
+
+	for i := 0; i < 10; i++ {
+		b.request()
+	}
+	for i := 0; i < 5; i++ {
+		assert.True(t, b.withdraw(), "retry %d", i)
+	}
+	assert.False(t, b.withdraw())
+	assert.Equal(t, RetryBudgetState{Ratio: 0.5, Requests: 10, Retries: 5, Exhausted: true}, b.state())
+
+	// the counts leave the window after 10 seconds
+	now = now.Add(5 * time.Second)
+	b.request()
+	now = now.Add(5 * time.Second)
+	assert.Equal(t, RetryBudgetState{Ratio: 0.5, Requests: 1, Retries: 0, Exhausted: false}, b.state())
+	assert.True(t, b.withdraw())
+}
+
+func TestRetryBudgetMinimum(t *testing.T) {
+	b := newRetryBudget(0.1)
+	b.request()
+	for i := 0; i < minRetryBudget; i++ {
+		assert.True(t, b.withdraw())
+	}
+	assert.False(t, b.withdraw())
+}
+
+func TestDoRetryBudgetExhausted(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithRetry(5, time.Millisecond)(client)
+	assert.Nil(t, WithRetryBudget(0.5)(client))
+
+	calls := 0
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		http.Error(w, "Service Unavailable", http.StatusServiceUnavailable)
+	})
+
+	_, resp, err := client.Epics.Get(context.Background(), "5")
+	assert.NotNil(t, err)
+	assert.Equal(t, 1+minRetryBudget, calls)
+	assert.Equal(t, 1+minRetryBudget, resp.Attempts)
+
+	_, resp, err = client.Epics.Get(context.Background(), "5")
+	assert.NotNil(t, err)
+	assert.Equal(t, 1, resp.Attempts)
+
+	state, ok := client.RetryBudget()
+	assert.True(t, ok)
+	assert.Equal(t, 2, state.Requests)
+	assert.Equal(t, minRetryBudget, state.Retries)
+	assert.True(t, state.Exhausted)
+}
+
+func TestWithRetryBudgetInvalid(t *testing.T) {
+	_, err := NewClient(defaultBaseURL, nil, WithRetryBudget(0))
+	assert.NotNil(t, err)
+
+	c, _ := NewClient(defaultBaseURL, nil)
+	_, ok := c.RetryBudget()
+	assert.False(t, ok)
+}
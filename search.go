@@ -0,0 +1,352 @@
+package jira
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// SearchService handles communication with the search related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/search
+type SearchService service
+
+// SearchResult represents the data returned by the search, in addition
+// to the issues information, paging data is returned
+type SearchResult struct {
+	Pagination
+	Expand string   `json:"expand,omitempty"`
+	Issues []*Issue `json:"issues,omitempty"`
+}
+
+// SearchOptions contains all options to search issues using JQL
+type SearchOptions struct {
+	//The index of the first issue to return (0-based)
+	StartAt int `query:"startAt"`
+	//The maximum number of issues to return. Default: 50.
+	MaxResults int `query:"maxResults"`
+	//The list of fields to return for each issue. By default, all navigable fields are returned.
+	Fields []string `query:"fields"`
+	//A list of the parameters to expand.
+	Expand []string `query:"expand"`
+	//Whether to validate the JQL query: true (default) or false, which ignores the unknown issue keys
+	//and values, for example. Jira Cloud also accepts strict, warn and none.
+	ValidateQuery string `query:"validateQuery"`
+	//Reports the progress of SearchAll and SearchIssues after each page, see Progress. It is not sent to the API.
+	Progress Progress `query:"-"`
+	//The position from which SearchAll and SearchIssues resume the search, advanced after each page, see
+	//IssuesOptions.Cursor. It is not sent to the API.
+	Cursor *PageCursor `query:"-"`
+}
+
+// Search searches for issues using JQL. The search result contains the total of issues
+// found, IsLast is set when the returned page is the last one.
+//
+// GET /rest/api/2/search
+func (s *SearchService) Search(ctx context.Context, jql string, opts *SearchOptions) (*SearchResult, *Response, error) {
+
+	q := QueryParameters(opts)
+	if q == "" {
+		q = "?"
+	} else {
+		q += "&"
+	}
+	q += "jql=" + url.QueryEscape(jql)
+
+	req, err := s.client.NewRequest("GET", s.client.apiPath("search"+q), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result = &SearchResult{}
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	result.IsLast = len(result.Issues) == 0 || result.StartAt+len(result.Issues) >= result.Total
+
+	resp.MaxResults = result.MaxResults
+	resp.StartAt = result.StartAt
+	resp.IsLast = result.IsLast
+	resp.Total = result.Total
+	resp.pageLen = len(result.Issues)
+
+	return result, resp, nil
+}
+
+// SearchAll searches for issues using JQL, following the pagination until the last page
+// is reached. If a page fails, the issues fetched so far are returned along with the error.
+// The returned response contains the pagination data of the last page.
+//
+// GET /rest/api/2/search
+func (s *SearchService) SearchAll(ctx context.Context, jql string, opts *SearchOptions) ([]*Issue, *Response, error) {
+	var o SearchOptions
+	if opts != nil {
+		o = *opts
+	}
+
+	return s.searchAll(ctx, jql, &o.StartAt, &o.MaxResults, o.Progress, o.Cursor, func(ctx context.Context) (*SearchResult, *Response, error) {
+		return s.Search(ctx, jql, &o)
+	})
+}
+
+// SearchRequest contains the JQL and the options of a search sent by SearchPost
+type SearchRequest struct {
+	//The JQL that defines the search
+	JQL string `json:"jql"`
+	//The index of the first issue to return (0-based)
+	StartAt int `json:"startAt,omitempty"`
+	//The maximum number of issues to return. Default: 50.
+	MaxResults int `json:"maxResults,omitempty"`
+	//The list of fields to return for each issue. By default, all navigable fields are returned.
+	Fields []string `json:"fields,omitempty"`
+	//A list of the parameters to expand.
+	Expand []string `json:"expand,omitempty"`
+	//Whether to validate the JQL query, see SearchOptions.ValidateQuery.
+	ValidateQuery string `json:"validateQuery,omitempty"`
+	//Reports the progress of SearchPostAll after each page, see Progress. It is not sent to the API.
+	Progress Progress `json:"-"`
+	//The position from which SearchPostAll resumes the search, advanced after each page, see IssuesOptions.Cursor.
+	//It is not sent to the API.
+	Cursor *PageCursor `json:"-"`
+}
+
+// SearchPost searches for issues using JQL, as Search, the JQL being sent in the body of
+// the request rather than in its URL, so it is not bound by the URL length limits.
+//
+// POST /rest/api/2/search
+func (s *SearchService) SearchPost(ctx context.Context, search *SearchRequest) (*SearchResult, *Response, error) {
+	req, err := s.client.NewRequest("POST", s.client.apiPath("search"), search)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result = &SearchResult{}
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	result.IsLast = len(result.Issues) == 0 || result.StartAt+len(result.Issues) >= result.Total
+
+	resp.MaxResults = result.MaxResults
+	resp.StartAt = result.StartAt
+	resp.IsLast = result.IsLast
+	resp.Total = result.Total
+	resp.pageLen = len(result.Issues)
+
+	return result, resp, nil
+}
+
+// SearchPostAll searches for issues using JQL, as SearchAll, the JQL being sent in the body
+// of the requests, see SearchPost.
+//
+// POST /rest/api/2/search
+func (s *SearchService) SearchPostAll(ctx context.Context, search *SearchRequest) ([]*Issue, *Response, error) {
+	var r SearchRequest
+	if search != nil {
+		r = *search
+	}
+
+	return s.searchAll(ctx, r.JQL, &r.StartAt, &r.MaxResults, r.Progress, r.Cursor, func(ctx context.Context) (*SearchResult, *Response, error) {
+		return s.SearchPost(ctx, &r)
+	})
+}
+
+// searchAll follows the pagination of the search of jql until the last page is reached, setting
+// startAt before each page and reporting the progress and advancing the cursor after it
+func (s *SearchService) searchAll(ctx context.Context, jql string, startAt, maxResults *int, progress Progress, cursor *PageCursor, search func(context.Context) (*SearchResult, *Response, error)) ([]*Issue, *Response, error) {
+	*maxResults = s.client.pageSize(*maxResults)
+
+	if err := cursor.resume("search?jql="+url.QueryEscape(jql), false); err != nil {
+		return nil, nil, err
+	}
+	var verify string
+	if cursor != nil {
+		if cursor.Done {
+			return nil, nil, nil
+		}
+		*startAt, verify = cursor.StartAt, cursor.LastID
+	}
+
+	var all []*Issue
+	for {
+		if verify != "" {
+			// the first page also gets the last issue read before the cursor
+			*startAt--
+		}
+		result, resp, err := search(ctx)
+		if err != nil {
+			return all, resp, err
+		}
+		if verify != "" {
+			if len(result.Issues) == 0 || result.Issues[0].Key != verify {
+				return all, resp, ErrStaleCursor
+			}
+			result.Issues, verify = result.Issues[1:], ""
+		}
+		if n := len(result.Issues); n > 0 {
+			cursor.advance(resp, result.Issues[n-1].Key)
+		}
+
+		if all == nil && resp.KnownTotal() {
+			all = make([]*Issue, 0, resp.prealloc())
+		}
+		all = append(all, result.Issues...)
+		progress.report(len(all), resp)
+
+		if resp.IsLast {
+			cursor.finish()
+			return all, resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return all, resp, ctx.Err()
+		default:
+		}
+
+		*startAt = resp.StartAt + resp.pageLen
+	}
+}
+
+// SearchJQLRequest contains the JQL and the options of a search sent by SearchJQL
+type SearchJQLRequest struct {
+	//The JQL that defines the search, it must be bounded, e.g. by a project
+	JQL string `json:"jql"`
+	//The token of the page to return, the NextPageToken of the previous page. By default, the first page is returned.
+	NextPageToken string `json:"nextPageToken,omitempty"`
+	//The maximum number of issues to return. Default: 50.
+	MaxResults int `json:"maxResults,omitempty"`
+	//The list of fields to return for each issue. By default, only the id is returned.
+	Fields []string `json:"fields,omitempty"`
+	//The parameters to expand, separated by commas, e.g. names,changelog.
+	Expand string `json:"expand,omitempty"`
+	//The list of the issue properties to return for each issue.
+	Properties []string `json:"properties,omitempty"`
+	//Whether Fields refers to the fields by their key rather than their id.
+	FieldsByKeys bool `json:"fieldsByKeys,omitempty"`
+	//The ids of the issues just created or updated to include in the results, even if not indexed yet.
+	ReconcileIssues []int `json:"reconcileIssues,omitempty"`
+	//Reports the progress of SearchJQLAll after each page, see Progress. The total is always unknown.
+	//It is not sent to the API.
+	Progress Progress `json:"-"`
+	//The position from which SearchJQLAll resumes the search, its NextPageToken advanced after each page, see
+	//IssuesOptions.Cursor. It is not sent to the API.
+	Cursor *PageCursor `json:"-"`
+}
+
+// SearchJQLResult represents a page of the issues returned by SearchJQL. There is no total,
+// the next page is requested by NextPageToken, empty on the last page.
+type SearchJQLResult struct {
+	Issues        []*Issue `json:"issues,omitempty"`
+	NextPageToken string   `json:"nextPageToken,omitempty"`
+	IsLast        bool     `json:"isLast,omitempty"`
+}
+
+// SearchJQL searches for issues using the enhanced JQL search of Jira Cloud, which pages the
+// results by a token rather than by startAt. The JQL is sent in the body of the request. Jira
+// Data Center does not provide it, see Search. Like the other methods of the platform API, it
+// is sent to the version of the API of the client, 2 by default, see WithAPIVersion.
+//
+// POST /rest/api/2/search/jql
+func (s *SearchService) SearchJQL(ctx context.Context, search *SearchJQLRequest) (*SearchJQLResult, *Response, error) {
+	req, err := s.client.NewRequest("POST", s.client.apiPath("search/jql"), search)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var result = &SearchJQLResult{}
+	resp, err := s.client.Do(ctx, req, result)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	result.IsLast = result.NextPageToken == ""
+
+	resp.MaxResults = len(result.Issues)
+	if search != nil && search.MaxResults > 0 {
+		resp.MaxResults = search.MaxResults
+	}
+	resp.IsLast = result.IsLast
+	resp.pageLen = len(result.Issues)
+
+	return result, resp, nil
+}
+
+// SearchJQLAll searches for issues using the enhanced JQL search of Jira Cloud, following the
+// NextPageToken until the last page is reached. If a page fails, the issues fetched so far are
+// returned along with the error.
+//
+// POST /rest/api/2/search/jql
+func (s *SearchService) SearchJQLAll(ctx context.Context, search *SearchJQLRequest) ([]*Issue, *Response, error) {
+	var r SearchJQLRequest
+	if search != nil {
+		r = *search
+	}
+	r.MaxResults = s.client.pageSize(r.MaxResults)
+
+	cursor := r.Cursor
+	if err := cursor.resume("search/jql?jql="+url.QueryEscape(r.JQL), true); err != nil {
+		return nil, nil, err
+	}
+	if cursor != nil {
+		if cursor.Done {
+			return nil, nil, nil
+		}
+		r.NextPageToken = cursor.NextPageToken
+	}
+
+	var all []*Issue
+	for {
+		result, resp, err := s.SearchJQL(ctx, &r)
+		if err != nil {
+			return all, resp, err
+		}
+
+		all = append(all, result.Issues...)
+		r.Progress.report(len(all), resp)
+		if cursor != nil {
+			cursor.NextPageToken = result.NextPageToken
+		}
+
+		if result.IsLast {
+			cursor.finish()
+			return all, resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return all, resp, ctx.Err()
+		default:
+		}
+
+		r.NextPageToken = result.NextPageToken
+	}
+}
+
+// SearchIssues searches for all the issues matching the JQL with the search provided by the
+// server: the enhanced JQL search (SearchJQLAll) on Jira Cloud, where the former search is
+// being removed, and SearchAll on Jira Data Center. The deployment type is read once from the
+// server information, as done by WithAutoAPIVersion. opts.StartAt is ignored on Jira Cloud.
+func (s *SearchService) SearchIssues(ctx context.Context, jql string, opts *SearchOptions) ([]*Issue, *Response, error) {
+	cloud, err := s.client.IsCloud(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !cloud {
+		return s.SearchAll(ctx, jql, opts)
+	}
+
+	search := &SearchJQLRequest{JQL: jql}
+	if opts != nil {
+		search.MaxResults = opts.MaxResults
+		search.Fields = opts.Fields
+		search.Expand = strings.Join(opts.Expand, ",")
+		search.Progress = opts.Progress
+		search.Cursor = opts.Cursor
+	}
+	return s.SearchJQLAll(ctx, search)
+}
@@ -0,0 +1,251 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchServiceSearch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "project = MCP AND status = \"In Progress\"", r.URL.Query().Get("jql"))
+		assert.Equal(t, "summary,status", r.URL.Query().Get("fields"))
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 50,"total": 1,"issues": [{"id": "1","key": "MCP-1"}]}`)
+	})
+
+	opts := &SearchOptions{Fields: []string{"summary", "status"}}
+	result, resp, err := client.Search.Search(context.Background(), `project = MCP AND status = "In Progress"`, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result.Total)
+	assert.Equal(t, 1, result.Pagination.Total)
+	assert.True(t, result.KnownTotal())
+	assert.True(t, resp.KnownTotal())
+	assert.Len(t, result.Issues, 1)
+	assert.Equal(t, "MCP-1", result.Issues[0].Key)
+	assert.True(t, resp.IsLast)
+	assert.Equal(t, 50, resp.MaxResults)
+}
+
+func TestSearchServiceSearchAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "project = MCP", r.URL.Query().Get("jql"))
+		switch r.URL.Query().Get("startAt") {
+		case "":
+			fmt.Fprint(w, `{"startAt": 0,"maxResults": 2,"total": 3,"issues": [{"id": "1","key": "MCP-1"},{"id": "2","key": "MCP-2"}]}`)
+		case "2":
+			fmt.Fprint(w, `{"startAt": 2,"maxResults": 2,"total": 3,"issues": [{"id": "3","key": "MCP-3"}]}`)
+		default:
+			t.Errorf("unexpected startAt %q", r.URL.Query().Get("startAt"))
+		}
+	})
+
+	var progress []int
+	opts := &SearchOptions{MaxResults: 2, Progress: func(fetched, total int) {
+		assert.Equal(t, 3, total)
+		progress = append(progress, fetched)
+	}}
+	issues, resp, err := client.Search.SearchAll(context.Background(), "project = MCP", opts)
+	assert.Nil(t, err)
+	assert.Len(t, issues, 3)
+	assert.Equal(t, []int{2, 3}, progress)
+	assert.Equal(t, 2, resp.StartAt)
+	assert.True(t, resp.IsLast)
+}
+
+func TestSearchServiceSearchPost(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"jql": "project = MCP","maxResults": 10,"fields": ["summary"],"expand": ["changelog"]}`, string(body))
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 10,"total": 1,"issues": [{"id": "1","key": "MCP-1"}]}`)
+	})
+
+	result, resp, err := client.Search.SearchPost(context.Background(), &SearchRequest{JQL: "project = MCP", MaxResults: 10, Fields: []string{"summary"}, Expand: []string{"changelog"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result.Total)
+	assert.Equal(t, "MCP-1", result.Issues[0].Key)
+	assert.True(t, resp.IsLast)
+	assert.Equal(t, 10, resp.MaxResults)
+}
+
+func TestSearchServiceSearchPostAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		var search SearchRequest
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&search))
+		assert.Equal(t, "project = MCP", search.JQL)
+		assert.Equal(t, 2, search.MaxResults)
+		switch search.StartAt {
+		case 0:
+			fmt.Fprint(w, `{"startAt": 0,"maxResults": 2,"total": 3,"issues": [{"id": "1","key": "MCP-1"},{"id": "2","key": "MCP-2"}]}`)
+		case 2:
+			fmt.Fprint(w, `{"startAt": 2,"maxResults": 2,"total": 3,"issues": [{"id": "3","key": "MCP-3"}]}`)
+		default:
+			t.Errorf("unexpected startAt %d", search.StartAt)
+		}
+	})
+
+	issues, resp, err := client.Search.SearchPostAll(context.Background(), &SearchRequest{JQL: "project = MCP", MaxResults: 2})
+	assert.Nil(t, err)
+	assert.Len(t, issues, 3)
+	assert.Equal(t, 2, resp.StartAt)
+	assert.True(t, resp.IsLast)
+}
+
+func TestSearchServiceSearchJQLAll(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		var search SearchJQLRequest
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&search))
+		assert.Equal(t, "project = MCP", search.JQL)
+		assert.Equal(t, []string{"summary"}, search.Fields)
+		assert.Equal(t, 2, search.MaxResults)
+		switch search.NextPageToken {
+		case "":
+			fmt.Fprint(w, `{"issues": [{"id": "1","key": "MCP-1"},{"id": "2","key": "MCP-2"}],"nextPageToken": "abc"}`)
+		case "abc":
+			fmt.Fprint(w, `{"issues": [{"id": "3","key": "MCP-3"}],"isLast": true}`)
+		default:
+			t.Errorf("unexpected nextPageToken %q", search.NextPageToken)
+		}
+	})
+
+	issues, resp, err := client.Search.SearchJQLAll(context.Background(), &SearchJQLRequest{JQL: "project = MCP", MaxResults: 2, Fields: []string{"summary"}})
+	assert.Nil(t, err)
+	assert.Len(t, issues, 3)
+	assert.Equal(t, "MCP-3", issues[2].Key)
+	assert.True(t, resp.IsLast)
+}
+
+func TestSearchServiceSearchJQLAllCursor(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	fail := true
+	mux.HandleFunc("/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		var search SearchJQLRequest
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&search))
+		switch search.NextPageToken {
+		case "":
+			fmt.Fprint(w, `{"issues": [{"id": "1","key": "MCP-1"}],"nextPageToken": "abc"}`)
+		case "abc":
+			if fail {
+				fail = false
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			fmt.Fprint(w, `{"issues": [{"id": "2","key": "MCP-2"}],"isLast": true}`)
+		}
+	})
+
+	cursor := &PageCursor{}
+	search := &SearchJQLRequest{JQL: "project = MCP", Cursor: cursor}
+	issues, _, err := client.Search.SearchJQLAll(context.Background(), search)
+	assert.NotNil(t, err)
+	assert.Len(t, issues, 1)
+	assert.Equal(t, PageCursor{Scope: "search/jql?jql=project+%3D+MCP", NextPageToken: "abc"}, *cursor)
+
+	issues, _, err = client.Search.SearchJQLAll(context.Background(), search)
+	assert.Nil(t, err)
+	if assert.Len(t, issues, 1) {
+		assert.Equal(t, "MCP-2", issues[0].Key)
+	}
+	assert.True(t, cursor.Done)
+
+	for _, c := range []*PageCursor{
+		{Scope: "search/jql?jql=project+%3D+OTHER", NextPageToken: "abc"},
+		{Scope: cursor.Scope, StartAt: 2, LastID: "MCP-2"},
+	} {
+		_, _, err = client.Search.SearchJQLAll(context.Background(), &SearchJQLRequest{JQL: "project = MCP", Cursor: c})
+		assert.Equal(t, ErrInvalidCursor, err)
+	}
+}
+
+func TestSearchServiceSearchAllCursor(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("startAt") {
+		case "1":
+			fmt.Fprint(w, `{"startAt": 1,"maxResults": 2,"total": 3,"issues": [{"id": "2","key": "MCP-2"},{"id": "3","key": "MCP-3"}]}`)
+		default:
+			t.Errorf("unexpected startAt %q", r.URL.Query().Get("startAt"))
+		}
+	})
+
+	cursor := &PageCursor{Scope: "search?jql=project+%3D+MCP", StartAt: 2, LastID: "MCP-2"}
+	issues, _, err := client.Search.SearchAll(context.Background(), "project = MCP", &SearchOptions{MaxResults: 2, Cursor: cursor})
+	assert.Nil(t, err)
+	if assert.Len(t, issues, 1) {
+		assert.Equal(t, "MCP-3", issues[0].Key)
+	}
+	assert.Equal(t, PageCursor{Scope: "search?jql=project+%3D+MCP", StartAt: 3, LastID: "MCP-3", Done: true}, *cursor)
+
+	_, _, err = client.Search.SearchPostAll(context.Background(), &SearchRequest{JQL: "project = OTHER", Cursor: cursor})
+	assert.Equal(t, ErrInvalidCursor, err)
+}
+
+func TestSearchServiceSearchJQL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"issues": [{"id": "1","key": "MCP-1"}],"nextPageToken": "abc"}`)
+	})
+
+	result, resp, err := client.Search.SearchJQL(context.Background(), &SearchJQLRequest{JQL: "project = MCP", MaxResults: 1})
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", result.NextPageToken)
+	assert.False(t, result.IsLast)
+	assert.False(t, resp.IsLast)
+	assert.Equal(t, 1, resp.MaxResults)
+}
+
+func TestSearchServiceSearchIssues(t *testing.T) {
+	for deploymentType, path := range map[string]string{"Cloud": "/api/2/search/jql", "Server": "/api/2/search"} {
+		client, mux, _, teardown := setup()
+
+		mux.HandleFunc("/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `{"deploymentType": "%s"}`, deploymentType)
+		})
+		mux.HandleFunc("/api/2/search", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/2/search", path, deploymentType)
+			assert.Equal(t, "project = MCP", r.URL.Query().Get("jql"))
+			fmt.Fprint(w, `{"startAt": 0,"maxResults": 50,"total": 1,"issues": [{"id": "1","key": "MCP-1"}]}`)
+		})
+		mux.HandleFunc("/api/2/search/jql", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/api/2/search/jql", path, deploymentType)
+			var search SearchJQLRequest
+			assert.Nil(t, json.NewDecoder(r.Body).Decode(&search))
+			assert.Equal(t, "names,changelog", search.Expand)
+			fmt.Fprint(w, `{"issues": [{"id": "1","key": "MCP-1"}]}`)
+		})
+
+		issues, _, err := client.Search.SearchIssues(context.Background(), "project = MCP", &SearchOptions{Expand: []string{"names", "changelog"}})
+		assert.Nil(t, err, deploymentType)
+		assert.Len(t, issues, 1, deploymentType)
+
+		teardown()
+	}
+}
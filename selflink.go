@@ -0,0 +1,55 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// ErrSelfLinkHost is returned by GetFromSelfLink when the self link is not on the host
+// of the BaseURL, nor on a host allowed by WithSelfLinkHosts
+var ErrSelfLinkHost = errors.New("jira: the self link is not on the host of the base URL")
+
+// GetFromSelfLink fetches the resource of the given self link, e.g. Epic.SelfLink, decoding it
+// into v. The link must be an absolute URL on the scheme and host of the BaseURL, or on a host
+// allowed by WithSelfLinkHosts, so the credentials of the client are not sent to another host.
+// ErrSelfLinkHost is returned otherwise, no request being sent.
+func (c *Client) GetFromSelfLink(ctx context.Context, selfURL string, v interface{}) (*Response, error) {
+	u, err := url.Parse(selfURL)
+	if err != nil {
+		return nil, err
+	}
+	if !c.selfLinkAllowed(u) {
+		return nil, ErrSelfLinkHost
+	}
+
+	req, err := c.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(ctx, req, v)
+}
+
+// selfLinkAllowed reports whether u is on the scheme and host of the BaseURL or on
+// a host allowed by WithSelfLinkHosts
+func (c *Client) selfLinkAllowed(u *url.URL) bool {
+	if !u.IsAbs() || u.Host == "" || u.User != nil {
+		return false
+	}
+
+	if strings.EqualFold(u.Scheme, c.BaseURL.Scheme) && strings.EqualFold(u.Host, c.BaseURL.Host) {
+		return true
+	}
+
+	if !strings.EqualFold(u.Scheme, "https") {
+		return false
+	}
+	for _, host := range c.selfLinkHosts {
+		if strings.EqualFold(u.Host, host) {
+			return true
+		}
+	}
+	return false
+}
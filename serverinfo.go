@@ -0,0 +1,149 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// DeploymentTypeCloud is the deployment type of Jira Cloud, see ServerInfo.DeploymentType
+const DeploymentTypeCloud = "Cloud"
+
+// ServerInfoService handles communication with the server information
+// method of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/serverInfo
+type ServerInfoService service
+
+// ServerInfo represents the information about the Jira instance
+type ServerInfo struct {
+	BaseURL        string `json:"baseUrl,omitempty"`
+	Version        string `json:"version,omitempty"`
+	VersionNumbers []int  `json:"versionNumbers,omitempty"`
+	// DeploymentType is Cloud, Server or DataCenter
+	DeploymentType string   `json:"deploymentType,omitempty"`
+	BuildNumber    int      `json:"buildNumber,omitempty"`
+	BuildDate      DateTime `json:"buildDate,omitempty"`
+	ServerTime     DateTime `json:"serverTime,omitempty"`
+	ScmInfo        string   `json:"scmInfo,omitempty"`
+	ServerTitle    string   `json:"serverTitle,omitempty"`
+}
+
+// IsCloud reports whether the server is a Jira Cloud instance
+func (s *ServerInfo) IsCloud() bool {
+	return s.DeploymentType == DeploymentTypeCloud
+}
+
+// Get returns the information about the Jira instance, e.g. its version and deployment type.
+//
+// GET /rest/api/2/serverInfo
+func (s *ServerInfoService) Get(ctx context.Context) (*ServerInfo, *Response, error) {
+
+	req, err := s.client.NewRequest("GET", s.client.apiPath("serverInfo"), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var info = &ServerInfo{}
+	resp, err := s.client.Do(ctx, req, info)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return info, resp, nil
+}
+
+// IsCloud reports whether the client is connected to a Jira Cloud instance, as opposed to
+// Jira Server or Data Center. The server information is requested on the first call only
+// and cached by the client.
+func (c *Client) IsCloud(ctx context.Context) (bool, error) {
+	c.serverInfoMu.Lock()
+	defer c.serverInfoMu.Unlock()
+
+	if c.serverInfo == nil {
+		// the server information does not depend on the API version, and resolving it, see
+		// WithAutoAPIVersion, calls IsCloud, which would wait for the lock held here
+		info, _, err := c.ServerInfo.Get(context.WithValue(ctx, resolvingAPIVersionKey{}, true))
+		if err != nil {
+			return false, err
+		}
+		c.serverInfo = info
+	}
+
+	return c.serverInfo.IsCloud(), nil
+}
+
+// resolvingAPIVersionKey is the context key that marks the request sent to resolve
+// the API version, which must not wait for the resolution itself
+type resolvingAPIVersionKey struct{}
+
+// version returns the version of the Jira platform REST API used by the client
+func (c *Client) version() string {
+	c.apiVersionMu.RLock()
+	defer c.apiVersionMu.RUnlock()
+
+	return c.apiVersion
+}
+
+// resolveAPIVersion sets the version of the API from the deployment type of the server,
+// once, when WithAutoAPIVersion is enabled and WithAPIVersion is not
+func (c *Client) resolveAPIVersion(ctx context.Context) error {
+	if !c.autoAPIVersion || c.apiVersionSet || ctx.Value(resolvingAPIVersionKey{}) != nil {
+		return nil
+	}
+
+	c.resolveMu.Lock()
+	defer c.resolveMu.Unlock()
+
+	if c.apiVersionResolved {
+		return nil
+	}
+
+	cloud, err := c.IsCloud(context.WithValue(ctx, resolvingAPIVersionKey{}, true))
+	if err != nil {
+		return err
+	}
+
+	c.apiVersionMu.Lock()
+	c.apiVersion = "2"
+	if cloud {
+		c.apiVersion = "3"
+	}
+	c.apiVersionMu.Unlock()
+	c.apiVersionResolved = true
+
+	return nil
+}
+
+// withResolvedAPIVersion resolves the API version, see resolveAPIVersion. The requests
+// created before, with the version 2 in their path, are copied with the resolved one.
+func (c *Client) withResolvedAPIVersion(ctx context.Context, req *http.Request) (*http.Request, error) {
+	if !c.autoAPIVersion || c.apiVersionSet {
+		return req, nil
+	}
+	if err := c.resolveAPIVersion(ctx); err != nil {
+		return nil, err
+	}
+
+	version := c.version()
+	api, err := c.BaseURL.Parse("../../api/")
+	if err != nil || version == "2" || req.URL.Host != c.BaseURL.Host {
+		return req, nil
+	}
+
+	prefix := api.Path + "2/"
+	if !strings.HasPrefix(req.URL.Path, prefix) {
+		return req, nil
+	}
+
+	u := *req.URL
+	u.Path = api.Path + version + "/" + strings.TrimPrefix(u.Path, prefix)
+	if strings.HasPrefix(u.RawPath, prefix) {
+		u.RawPath = api.Path + version + "/" + strings.TrimPrefix(u.RawPath, prefix)
+	}
+
+	req2 := new(http.Request)
+	*req2 = *req
+	req2.URL = &u
+	return req2, nil
+}
@@ -0,0 +1,59 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerInfoServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"baseUrl": "https://jira.mycompany.com","version": "8.20.1","versionNumbers": [8,20,1],"deploymentType": "Server","buildNumber": 820001,"serverTitle": "My Jira"}`)
+	})
+
+	info, _, err := client.ServerInfo.Get(context.Background())
+	assert.Nil(t, err)
+	assert.Equal(t, "8.20.1", info.Version)
+	assert.Equal(t, []int{8, 20, 1}, info.VersionNumbers)
+	assert.Equal(t, "Server", info.DeploymentType)
+	assert.Equal(t, 820001, info.BuildNumber)
+	assert.Equal(t, "My Jira", info.ServerTitle)
+	assert.False(t, info.IsCloud())
+}
+
+func TestClientIsCloud(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	calls := 0
+	mux.HandleFunc("/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		fmt.Fprint(w, `{"version": "1001.0.0-SNAPSHOT","deploymentType": "Cloud"}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		cloud, err := client.IsCloud(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, cloud)
+	}
+	assert.Equal(t, 1, calls)
+}
+
+func TestClientIsCloudError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/serverInfo", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := client.IsCloud(context.Background())
+	assert.NotNil(t, err)
+}
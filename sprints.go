@@ -2,6 +2,7 @@ package jira
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -13,6 +14,34 @@ import (
 // Jira Agile API docs: https://docs.atlassian.com/jira-software/REST/7.3.1/#agile/1.0/sprint
 type SprintsService service
 
+// SprintState represents the state of a sprint
+type SprintState string
+
+// States of a sprint
+const (
+	SprintStateFuture SprintState = "future"
+	SprintStateActive SprintState = "active"
+	SprintStateClosed SprintState = "closed"
+)
+
+// ErrInvalidSprintState is returned when a sprint state is not one of future, active or closed
+var ErrInvalidSprintState = errors.New("jira: invalid sprint state, valid values are future, active and closed")
+
+// validateSprintStates checks that the states are SprintStateFuture, SprintStateActive or SprintStateClosed
+func validateSprintStates(states []SprintState) error {
+	for _, state := range states {
+		switch state {
+		case SprintStateFuture, SprintStateActive, SprintStateClosed:
+		default:
+			return ErrInvalidSprintState
+		}
+	}
+	return nil
+}
+
+// ErrInvalidSprintDates is returned when a sprint is started without a valid start and end date
+var ErrInvalidSprintDates = errors.New("jira: a sprint requires a start date before its end date to be started")
+
 // SprintWrap represents the data returned by the API,
 // in addition to the board information, paging data is returned
 type SprintWrap struct {
@@ -45,7 +74,7 @@ type NewSprint struct {
 
 // SwapSprint contains the options to swap a sprint
 type SwapSprint struct {
-	ID int `json:"sprintToSwapWith, omitempty"`
+	ID int `json:"sprintToSwapWith,omitempty"`
 }
 
 // SprintsOptions contains all options to list all sprints from a board
@@ -54,8 +83,8 @@ type SprintsOptions struct {
 	StartAt int `query:"startAt"`
 	//The maximum number of sprints to return per page. Default: 50. See the 'Pagination' section at the top of this page for more details.
 	MaxResults int `query:"maxResults"`
-	//Filters results to sprints in specified states. Valid values: future, active, closed. You can define multiple states separated by commas, e.g. state=active,closed
-	State string `query:"state"`
+	//Filters results to sprints in specified states, e.g. []SprintState{SprintStateActive, SprintStateClosed}.
+	State []SprintState `query:"state"`
 }
 
 // Create creates a future sprint. Sprint name and origin board id are required. Start and end date are optional.
@@ -158,11 +187,44 @@ func (s *SprintsService) PartiallyUpdate(ctx context.Context, sprintID int, spri
 	return sprint, resp, nil
 }
 
+// Start starts a future sprint, for a given sprint Id, updating its state to 'active' with
+// the given start and end date. ErrInvalidSprintDates is returned without calling the API
+// when a date is missing or the end date is not after the start date.
+// It returns the updated sprint.
+//
+// POST /rest/agile/1.0/sprint/{sprintId}
+func (s *SprintsService) Start(ctx context.Context, sprintID int, start, end time.Time) (*Sprint, *Response, error) {
+	if start.IsZero() || end.IsZero() || !end.After(start) {
+		return nil, nil, ErrInvalidSprintDates
+	}
+
+	return s.PartiallyUpdate(ctx, sprintID, &Sprint{
+		State: string(SprintStateActive),
+		Start: &start,
+		End:   &end,
+	})
+}
+
+// Complete completes an active sprint, for a given sprint Id, updating its state to 'closed'.
+// The complete date is set by Jira to the time of the request. It returns the updated sprint.
+//
+// POST /rest/agile/1.0/sprint/{sprintId}
+func (s *SprintsService) Complete(ctx context.Context, sprintID int) (*Sprint, *Response, error) {
+	return s.PartiallyUpdate(ctx, sprintID, &Sprint{
+		State: string(SprintStateClosed),
+	})
+}
+
 // MoveIssuesTo Moves issues to a sprint, for a given sprint Id. Issues can only be moved to open or
-// active sprints. The maximum number of issues that can be moved in one operation is 50.
+// active sprints. The maximum number of issues that can be moved in one operation is 50,
+// ErrTooManyIssues is returned without calling the API when it is exceeded and ErrNoIssues
+// when no issues are given.
 //
 // POST /rest/agile/1.0/sprint/{sprintId}/issue
 func (s *SprintsService) MoveIssuesTo(ctx context.Context, sprintID int, issueKeys *IssueKeys) (bool, *Response, error) {
+	if err := issueKeys.validate(); err != nil {
+		return false, nil, err
+	}
 
 	req, err := s.client.NewRequest("POST", fmt.Sprintf("sprint/%d/issue", sprintID), issueKeys)
 	if err != nil {
@@ -202,6 +264,8 @@ func (s *SprintsService) ListIssues(ctx context.Context, sprintID int, opts *Iss
 	resp.MaxResults = wrap.MaxResults
 	resp.StartAt = wrap.StartAt
 	resp.IsLast = wrap.IsLast
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Values)
 
 	return wrap.Values, resp, nil
 }
@@ -2,7 +2,9 @@ package jira
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"reflect"
 	"testing"
@@ -173,3 +175,78 @@ func TestSprintsServiceDelete(t *testing.T) {
 	assert.Nil(t, err)
 	assert.True(t, ok)
 }
+
+func TestSprintsServiceListIssuesJQL(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/sprint/111/issue", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, `assignee = "John Doe"`, r.URL.Query().Get("jql"))
+		_, _ = fmt.Fprint(w, issuesAsJSON)
+	})
+
+	issues, _, err := client.Sprints.ListIssues(context.Background(), 111, &IssuesOptions{JQL: `assignee = "John Doe"`})
+	assert.Nil(t, err)
+	assert.Len(t, issues, 1)
+}
+
+func TestSprintsServiceMoveIssuesToValidation(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Sprints.MoveIssuesTo(context.Background(), 5, &IssueKeys{})
+	assert.Equal(t, ErrNoIssues, err)
+
+	tooMany := &IssueKeys{Issues: make([]string, MaxIssuesPerMove+1)}
+	_, _, err = client.Sprints.MoveIssuesTo(context.Background(), 5, tooMany)
+	assert.Equal(t, ErrTooManyIssues, err)
+}
+
+func TestSprintsServiceStart(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/sprint/5259", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		sprint := &Sprint{}
+		json.NewDecoder(r.Body).Decode(sprint)
+		assert.Equal(t, "active", sprint.State)
+		assert.NotNil(t, sprint.Start)
+		assert.NotNil(t, sprint.End)
+
+		fmt.Fprint(w, `{"id": 5259,"state": "active","name": "Sprint 001","startDate": "2018-09-18T17:30:00.000Z","endDate": "2018-10-02T17:30:00.000Z"}`)
+	})
+
+	start := time.Date(2018, 9, 18, 17, 30, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 14)
+
+	sprint, _, err := client.Sprints.Start(context.Background(), 5259, start, end)
+	assert.Nil(t, err)
+	assert.Equal(t, "active", sprint.State)
+
+	_, _, err = client.Sprints.Start(context.Background(), 5259, start, time.Time{})
+	assert.Equal(t, ErrInvalidSprintDates, err)
+
+	_, _, err = client.Sprints.Start(context.Background(), 5259, end, start)
+	assert.Equal(t, ErrInvalidSprintDates, err)
+}
+
+func TestSprintsServiceComplete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/sprint/5259", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, `{"state":"closed"}`+"\n", string(body))
+
+		fmt.Fprint(w, `{"id": 5259,"state": "closed","name": "Sprint 001","completeDate": "2018-10-02T17:30:00.000Z"}`)
+	})
+
+	sprint, _, err := client.Sprints.Complete(context.Background(), 5259)
+	assert.Nil(t, err)
+	assert.Equal(t, "closed", sprint.State)
+	assert.NotNil(t, sprint.Complete)
+}
@@ -0,0 +1,43 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusesServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/status", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `[{"id": "3","name": "In Progress","description": "This issue is being actively worked on.","iconUrl": "https://jira.mycompany.com/images/icons/statuses/inprogress.png"},{"id": "10001","name": "Done","description": ""}]`)
+	})
+
+	statuses, _, err := client.Statuses.List(context.Background())
+	assert.Nil(t, err)
+	assert.Len(t, statuses, 2)
+	assert.Equal(t, "In Progress", statuses[0].Name)
+	assert.Equal(t, "This issue is being actively worked on.", statuses[0].Description)
+	assert.Equal(t, "Done", statuses[1].Name)
+}
+
+func TestStatusesServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/status/3", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": "3","name": "In Progress","description": "This issue is being actively worked on.","iconUrl": "https://jira.mycompany.com/images/icons/statuses/inprogress.png"}`)
+	})
+
+	status, _, err := client.Statuses.Get(context.Background(), "3")
+	assert.Nil(t, err)
+	assert.Equal(t, "3", status.ID)
+	assert.Equal(t, "In Progress", status.Name)
+	assert.Equal(t, "https://jira.mycompany.com/images/icons/statuses/inprogress.png", status.IconURL)
+}
@@ -0,0 +1,118 @@
+package jira
+
+import (
+	"crypto/tls"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// TraceInfo reports the timings of a request sent by the client and the connection which
+// sent it, see WithHTTPTrace. The durations are zero for the steps skipped, e.g. DNS,
+// Connect and TLSHandshake when the connection is reused.
+type TraceInfo struct {
+	Method string
+	URL    string
+	// DNS is the duration of the DNS lookup
+	DNS time.Duration
+	// Connect is the duration of the TCP connection, the DNS lookup excluded
+	Connect time.Duration
+	// TLSHandshake is the duration of the TLS handshake
+	TLSHandshake time.Duration
+	// TimeToFirstByte is the duration from the start of the request to the first byte of the response
+	TimeToFirstByte time.Duration
+	// Total is the duration from the start of the request to the response headers, or the error
+	Total time.Duration
+	// Reused reports whether the connection was reused from a previous request
+	Reused bool
+	// WasIdle reports whether the reused connection was idle, and for how long
+	WasIdle  bool
+	IdleTime time.Duration
+	// Err is the error returned by the HTTP client, if any
+	Err error
+}
+
+// tracer collects the trace of a request
+type tracer struct {
+	mu    sync.Mutex
+	info  TraceInfo
+	start time.Time
+	conn  bool
+
+	dnsStart, connectStart, tlsStart time.Time
+}
+
+// trace returns req with a context holding an httptrace.ClientTrace if WithHTTPTrace was used,
+// and a function reporting the trace once the response headers, or an error, are received.
+// A request sharing the response of another one, see WithRequestCoalescing, is not reported.
+func (c *Client) trace(req *http.Request) (*http.Request, func(error)) {
+	if c.httpTrace == nil {
+		return req, func(error) {}
+	}
+
+	t := &tracer{start: time.Now()}
+	t.info.Method = req.Method
+	t.info.URL = req.URL.String()
+
+	trace := &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.mu.Lock()
+			t.dnsStart = time.Now()
+			t.mu.Unlock()
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.mu.Lock()
+			t.info.DNS = time.Since(t.dnsStart)
+			t.mu.Unlock()
+		},
+		ConnectStart: func(string, string) {
+			t.mu.Lock()
+			t.connectStart = time.Now()
+			t.mu.Unlock()
+		},
+		ConnectDone: func(string, string, error) {
+			t.mu.Lock()
+			t.info.Connect = time.Since(t.connectStart)
+			t.mu.Unlock()
+		},
+		TLSHandshakeStart: func() {
+			t.mu.Lock()
+			t.tlsStart = time.Now()
+			t.mu.Unlock()
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.mu.Lock()
+			t.info.TLSHandshake = time.Since(t.tlsStart)
+			t.mu.Unlock()
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			t.conn = true
+			t.info.Reused = info.Reused
+			t.info.WasIdle = info.WasIdle
+			t.info.IdleTime = info.IdleTime
+			t.mu.Unlock()
+		},
+		GotFirstResponseByte: func() {
+			t.mu.Lock()
+			t.info.TimeToFirstByte = time.Since(t.start)
+			t.mu.Unlock()
+		},
+	}
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), trace))
+
+	return req, func(err error) {
+		t.mu.Lock()
+		info := t.info
+		conn := t.conn
+		t.mu.Unlock()
+
+		if !conn && err == nil {
+			return
+		}
+		info.Total = time.Since(t.start)
+		info.Err = err
+		c.httpTrace(info)
+	}
+}
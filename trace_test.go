@@ -0,0 +1,63 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithHTTPTrace(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var (
+		mu     sync.Mutex
+		traces []TraceInfo
+	)
+	WithHTTPTrace(func(info TraceInfo) {
+		mu.Lock()
+		traces = append(traces, info)
+		mu.Unlock()
+	})(client)
+
+	mux.HandleFunc("/epic/5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": 5,"key": "MCP-5"}`)
+	})
+
+	for i := 0; i < 2; i++ {
+		_, _, err := client.Epics.Get(context.Background(), "5")
+		assert.Nil(t, err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Len(t, traces, 2)
+	assert.Equal(t, "GET", traces[0].Method)
+	assert.Equal(t, client.BaseURL.String()+"epic/5", traces[0].URL)
+	assert.False(t, traces[0].Reused)
+	assert.True(t, traces[0].Connect > 0)
+	assert.True(t, traces[0].TimeToFirstByte > 0)
+	assert.True(t, traces[0].Total >= traces[0].TimeToFirstByte)
+	assert.True(t, traces[1].Reused)
+	assert.Equal(t, int64(0), int64(traces[1].Connect))
+	assert.Nil(t, traces[1].Err)
+}
+
+func TestWithHTTPTraceError(t *testing.T) {
+	client, _, _, teardown := setup()
+	teardown()
+
+	var traces []TraceInfo
+	WithHTTPTrace(func(info TraceInfo) {
+		traces = append(traces, info)
+	})(client)
+
+	_, _, err := client.Epics.Get(context.Background(), "5")
+	assert.NotNil(t, err)
+	assert.Len(t, traces, 1)
+	assert.NotNil(t, traces[0].Err)
+}
@@ -0,0 +1,77 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+// ErrEmptyAccountID is returned when no account id is given to get a user. Jira Cloud
+// identifies users by account id only, usernames are not accepted anymore.
+var ErrEmptyAccountID = errors.New("jira: account id is required")
+
+// UsersService handles communication with the user related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/user
+type UsersService service
+
+// UserSearchOptions contains all options to search for users
+type UserSearchOptions struct {
+	//The index of the first user to return (0-based)
+	StartAt int `query:"startAt"`
+	//The maximum number of users to return. Default: 50.
+	MaxResults int `query:"maxResults"`
+}
+
+// Search returns the active users that match the query, a string matched against
+// the display name and the email address of the users.
+//
+// GET /rest/api/2/user/search
+func (u *UsersService) Search(ctx context.Context, query string, opts *UserSearchOptions) ([]*IssueUser, *Response, error) {
+
+	q := QueryParameters(opts)
+	if q == "" {
+		q = "?"
+	} else {
+		q += "&"
+	}
+	q += "query=" + url.QueryEscape(query)
+
+	req, err := u.client.NewRequest("GET", u.client.apiPath(fmt.Sprintf("user/search%s", q)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var users []*IssueUser
+	resp, err := u.client.Do(ctx, req, &users)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return users, resp, nil
+}
+
+// Get returns a user, for a given account id. ErrEmptyAccountID is returned
+// without calling the API when the account id is empty.
+//
+// GET /rest/api/2/user?accountId={accountId}
+func (u *UsersService) Get(ctx context.Context, accountID string) (*IssueUser, *Response, error) {
+	if accountID == "" {
+		return nil, nil, ErrEmptyAccountID
+	}
+
+	req, err := u.client.NewRequest("GET", u.client.apiPath("user?accountId="+url.QueryEscape(accountID)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var user = &IssueUser{}
+	resp, err := u.client.Do(ctx, req, user)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return user, resp, nil
+}
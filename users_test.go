@@ -0,0 +1,55 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsersServiceSearch(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/user/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "mia k", r.URL.Query().Get("query"))
+		assert.Equal(t, "10", r.URL.Query().Get("maxResults"))
+		fmt.Fprint(w, `[{"accountId": "5b10a2844c20165700ede21g","displayName": "Mia Krystof","emailAddress": "mia@example.com","active": true,"avatarUrls": {"48x48": "https://avatar-management/48"}}]`)
+	})
+
+	users, _, err := client.Users.Search(context.Background(), "mia k", &UserSearchOptions{MaxResults: 10})
+	assert.Nil(t, err)
+	assert.Len(t, users, 1)
+	assert.Equal(t, "5b10a2844c20165700ede21g", users[0].AccountID)
+	assert.Equal(t, "mia@example.com", users[0].Email)
+	assert.True(t, users[0].Active)
+	assert.Equal(t, "https://avatar-management/48", users[0].AvatarURLs["48x48"])
+}
+
+func TestUsersServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/user", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "5b10a2844c20165700ede21g", r.URL.Query().Get("accountId"))
+		fmt.Fprint(w, `{"accountId": "5b10a2844c20165700ede21g","displayName": "Mia Krystof"}`)
+	})
+
+	user, _, err := client.Users.Get(context.Background(), "5b10a2844c20165700ede21g")
+	assert.Nil(t, err)
+	assert.Equal(t, "Mia Krystof", user.DisplayName)
+}
+
+func TestUsersServiceGetEmptyAccountID(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	user, resp, err := client.Users.Get(context.Background(), "")
+	assert.Equal(t, ErrEmptyAccountID, err)
+	assert.Nil(t, user)
+	assert.Nil(t, resp)
+}
@@ -0,0 +1,103 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// VersionsService handles communication with the project version related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/version
+type VersionsService service
+
+// List returns all versions of a project, for a given project Id or project key.
+//
+// GET /rest/api/2/project/{projectIdOrKey}/versions
+func (v *VersionsService) List(ctx context.Context, projectIDOrKey string) ([]*IssueVersion, *Response, error) {
+
+	req, err := v.client.NewRequest("GET", v.client.apiPath(fmt.Sprintf("project/%s/versions", projectIDOrKey)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var versions []*IssueVersion
+	resp, err := v.client.Do(ctx, req, &versions)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return versions, resp, nil
+}
+
+// Get returns a project version, for a given version Id.
+//
+// GET /rest/api/2/version/{id}
+func (v *VersionsService) Get(ctx context.Context, id string) (*IssueVersion, *Response, error) {
+
+	req, err := v.client.NewRequest("GET", v.client.apiPath(fmt.Sprintf("version/%s", id)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var version = &IssueVersion{}
+	resp, err := v.client.Do(ctx, req, version)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return version, resp, nil
+}
+
+// Create creates a project version. The project is given by IssueVersion.Project or
+// IssueVersion.ProjectID. It returns the created version.
+//
+// POST /rest/api/2/version
+func (v *VersionsService) Create(ctx context.Context, version *IssueVersion) (*IssueVersion, *Response, error) {
+
+	req, err := v.client.NewRequest("POST", v.client.apiPath("version"), version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created = &IssueVersion{}
+	resp, err := v.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// Update updates a project version, for a given version Id. Only the fields set are
+// updated. It returns the updated version.
+//
+// PUT /rest/api/2/version/{id}
+func (v *VersionsService) Update(ctx context.Context, id string, version *IssueVersion) (*IssueVersion, *Response, error) {
+
+	req, err := v.client.NewRequest("PUT", v.client.apiPath(fmt.Sprintf("version/%s", id)), version)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated = &IssueVersion{}
+	resp, err := v.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, nil
+}
+
+// Delete deletes a project version, for a given version Id.
+//
+// DELETE /rest/api/2/version/{id}
+func (v *VersionsService) Delete(ctx context.Context, id string) (*Response, error) {
+
+	req, err := v.client.NewRequest("DELETE", v.client.apiPath(fmt.Sprintf("version/%s", id)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,97 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionsServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/project/MCP/versions", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `[{"id": "10000","name": "1.0","released": true,"releaseDate": "2020-07-06","projectId": 10000},{"id": "10001","name": "2.0","archived": true}]`)
+	})
+
+	versions, _, err := client.Versions.List(context.Background(), "MCP")
+	assert.Nil(t, err)
+	assert.Len(t, versions, 2)
+	assert.Equal(t, "1.0", versions[0].Name)
+	assert.True(t, versions[0].Released)
+	assert.Equal(t, "2020-07-06", versions[0].ReleaseDate)
+	assert.True(t, versions[1].Archived)
+}
+
+func TestVersionsServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/version/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"id": "10000","name": "1.0","overdue": true,"releaseDate": "2020-07-06"}`)
+	})
+
+	version, _, err := client.Versions.Get(context.Background(), "10000")
+	assert.Nil(t, err)
+	assert.Equal(t, "1.0", version.Name)
+	assert.True(t, version.Overdue)
+}
+
+func TestVersionsServiceCreate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/version", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"name": "1.0", "projectId": float64(10000), "releaseDate": "2020-07-06"}, body)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "10000","name": "1.0","projectId": 10000,"releaseDate": "2020-07-06"}`)
+	})
+
+	version, _, err := client.Versions.Create(context.Background(), &IssueVersion{Name: "1.0", ProjectID: 10000, ReleaseDate: "2020-07-06"})
+	assert.Nil(t, err)
+	assert.Equal(t, "10000", version.ID)
+}
+
+func TestVersionsServiceUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/version/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, map[string]interface{}{"released": true}, body)
+
+		fmt.Fprint(w, `{"id": "10000","name": "1.0","released": true}`)
+	})
+
+	version, _, err := client.Versions.Update(context.Background(), "10000", &IssueVersion{Released: true})
+	assert.Nil(t, err)
+	assert.True(t, version.Released)
+}
+
+func TestVersionsServiceDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/version/10000", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	resp, err := client.Versions.Delete(context.Background(), "10000")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
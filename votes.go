@@ -0,0 +1,101 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrVoteOwnIssue is returned by VotesService.Add when the current user tries to vote for an issue they reported
+var ErrVoteOwnIssue = errors.New("jira: cannot vote for an issue reported by the current user")
+
+// VotesService handles communication with the vote related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/issue-getVotes
+type VotesService service
+
+// Get returns the votes of an issue, for a given issue Id or issue key, and whether the current user has voted.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/votes
+func (v *VotesService) Get(ctx context.Context, issueIDOrKey string) (*IssueVote, *Response, error) {
+
+	req, err := v.client.NewRequest("GET", v.client.apiPath(fmt.Sprintf("issue/%s/votes", issueIDOrKey)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var vote = &IssueVote{}
+	resp, err := v.client.Do(ctx, req, vote)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return vote, resp, nil
+}
+
+// Add casts a vote of the current user for an issue, for a given issue Id or issue key.
+// If the issue was reported by the current user, ErrVoteOwnIssue is returned.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/votes
+func (v *VotesService) Add(ctx context.Context, issueIDOrKey string) (bool, *Response, error) {
+
+	req, err := v.client.NewRequest("POST", v.client.apiPath(fmt.Sprintf("issue/%s/votes", issueIDOrKey)), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := v.client.Do(ctx, req, nil)
+	if err != nil {
+		if isOwnIssueVote(err) {
+			err = ErrVoteOwnIssue
+		}
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// Remove removes the vote of the current user from an issue, for a given issue Id or issue key.
+//
+// DELETE /rest/api/2/issue/{issueIdOrKey}/votes
+func (v *VotesService) Remove(ctx context.Context, issueIDOrKey string) (bool, *Response, error) {
+
+	req, err := v.client.NewRequest("DELETE", v.client.apiPath(fmt.Sprintf("issue/%s/votes", issueIDOrKey)), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := v.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// isOwnIssueVote reports whether err is the error returned by Jira, with status 400 or 404
+// depending on the version, when a user votes for an issue they reported
+func isOwnIssueVote(err error) bool {
+	errResp, ok := err.(*ErrorResponse)
+	if !ok || (errResp.StatusCode != http.StatusBadRequest && errResp.StatusCode != http.StatusNotFound) {
+		return false
+	}
+
+	for _, m := range errResp.Messages {
+		if strings.Contains(strings.ToLower(m), "you have reported") {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVotesServiceGet(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/votes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"votes": 1,"hasVoted": true,"voters": [{"accountId": "5b10a2844c20165700ede21g"}]}`)
+	})
+
+	vote, _, err := client.Votes.Get(context.Background(), "MCP-1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, vote.Votes)
+	assert.True(t, vote.Voted)
+	assert.Len(t, vote.Voters, 1)
+	assert.Equal(t, "5b10a2844c20165700ede21g", vote.Voters[0].AccountID)
+}
+
+func TestVotesServiceAdd(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/votes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	added, _, err := client.Votes.Add(context.Background(), "MCP-1")
+	assert.Nil(t, err)
+	assert.True(t, added)
+}
+
+func TestVotesServiceAddOwnIssue(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/votes", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errorMessages": ["You cannot vote for an issue you have reported."]}`)
+	})
+
+	added, resp, err := client.Votes.Add(context.Background(), "MCP-1")
+	assert.Equal(t, ErrVoteOwnIssue, err)
+	assert.False(t, added)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestVotesServiceRemove(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/votes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	removed, _, err := client.Votes.Remove(context.Background(), "MCP-1")
+	assert.Nil(t, err)
+	assert.True(t, removed)
+}
@@ -0,0 +1,140 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// IssueEventType is the type of an IssueEvent
+type IssueEventType string
+
+const (
+	// IssueAdded is the type of the events of the issues added to the epic
+	IssueAdded IssueEventType = "added"
+	// IssueRemoved is the type of the events of the issues removed from the epic
+	IssueRemoved IssueEventType = "removed"
+)
+
+// IssueEvent is a change of the issues of an epic detected by EpicsService.WatchIssues. When
+// polling fails, Err is set and Type and Issue are empty.
+type IssueEvent struct {
+	Type IssueEventType
+	// Issue is the issue added, or the issue removed as it was last seen
+	Issue *Issue
+	Err   error
+}
+
+// maxWatchBackoff is the maximum factor applied to the interval of WatchIssues when rate limited
+const maxWatchBackoff = 16
+
+// WatchIssues polls the issues of the epic, for a given epic Id or key, on the given interval and
+// sends an event on the returned channel for each issue added to or removed from the epic, by issue
+// key, since the previous poll. The issues are listed by ListIssuesAll, the first listing, returning
+// its error if it fails, being the baseline. When a poll fails, an event with the error is sent and
+// the issues are polled again on the next interval. When rate limited, the interval is doubled, up
+// to 16 times, or set to the Retry-After delay, until a poll succeeds. The channel is closed when
+// ctx is done.
+//
+// GET /rest/agile/1.0/epic/{epicIdOrKey}/issue
+func (e *EpicsService) WatchIssues(ctx context.Context, idOrKey string, interval time.Duration) (<-chan IssueEvent, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("jira: invalid interval %v, it must be positive", interval)
+	}
+
+	list := func() ([]*Issue, *Response, error) {
+		return e.ListIssuesAll(ctx, idOrKey, &IssuesOptions{Dedup: true})
+	}
+	issues, _, err := list()
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan IssueEvent)
+	go func() {
+		defer close(events)
+
+		send := func(event IssueEvent) bool {
+			select {
+			case events <- event:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		seen := issuesByKey(issues)
+		delay := interval
+		for {
+			timer := time.NewTimer(delay)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			issues, resp, err := list()
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if errResp, ok := err.(*ErrorResponse); ok && errResp.StatusCode == http.StatusTooManyRequests {
+					delay = watchBackoff(delay, interval, resp)
+					continue
+				}
+				delay = interval
+				if !send(IssueEvent{Err: err}) {
+					return
+				}
+				continue
+			}
+			delay = interval
+
+			current := issuesByKey(issues)
+			for _, issue := range issues {
+				if _, ok := seen[issue.Key]; !ok && !send(IssueEvent{Type: IssueAdded, Issue: issue}) {
+					return
+				}
+			}
+			var removed []string
+			for key := range seen {
+				if _, ok := current[key]; !ok {
+					removed = append(removed, key)
+				}
+			}
+			sort.Strings(removed)
+			for _, key := range removed {
+				if !send(IssueEvent{Type: IssueRemoved, Issue: seen[key]}) {
+					return
+				}
+			}
+			seen = current
+		}
+	}()
+
+	return events, nil
+}
+
+// issuesByKey returns the issues indexed by key
+func issuesByKey(issues []*Issue) map[string]*Issue {
+	m := make(map[string]*Issue, len(issues))
+	for _, issue := range issues {
+		m[issue.Key] = issue
+	}
+	return m
+}
+
+// watchBackoff returns the delay before polling again when rate limited: the Retry-After delay of
+// the response, if any, or twice the previous delay, up to maxWatchBackoff times the interval
+func watchBackoff(delay, interval time.Duration, resp *Response) time.Duration {
+	if resp != nil && resp.RetryAfter > 0 {
+		return resp.RetryAfter
+	}
+	if delay *= 2; delay > maxWatchBackoff*interval {
+		delay = maxWatchBackoff * interval
+	}
+	return delay
+}
@@ -0,0 +1,117 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// epicIssuesServer serves the issues of an epic with the given keys, which can be changed
+type epicIssuesServer struct {
+	mu       sync.Mutex
+	keys     []string
+	statuses []int
+}
+
+func (s *epicIssuesServer) set(keys ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.keys = keys
+}
+
+func (s *epicIssuesServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.statuses) > 0 {
+		status := s.statuses[0]
+		s.statuses = s.statuses[1:]
+		w.WriteHeader(status)
+		return
+	}
+	var issues []string
+	for _, key := range s.keys {
+		issues = append(issues, fmt.Sprintf(`{"key":%q}`, key))
+	}
+	fmt.Fprintf(w, `{"startAt":0,"maxResults":50,"total":%d,"isLast":true,"issues":[%s]}`, len(issues), strings.Join(issues, ","))
+}
+
+// nextEvent returns the next event sent on events, failing the test after a second
+func nextEvent(t *testing.T, events <-chan IssueEvent) IssueEvent {
+	select {
+	case event := <-events:
+		return event
+	case <-time.After(time.Second):
+		t.Fatal("no event received")
+		return IssueEvent{}
+	}
+}
+
+func TestEpicsServiceWatchIssues(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	server := &epicIssuesServer{keys: []string{"MCP-1", "MCP-2"}}
+	mux.Handle("/epic/MCP-5/issue", server)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := client.Epics.WatchIssues(ctx, "MCP-5", 5*time.Millisecond)
+	assert.Nil(t, err)
+
+	server.set("MCP-1", "MCP-3", "MCP-4")
+	event := nextEvent(t, events)
+	assert.Equal(t, IssueAdded, event.Type)
+	assert.Equal(t, "MCP-3", event.Issue.Key)
+	event = nextEvent(t, events)
+	assert.Equal(t, IssueAdded, event.Type)
+	assert.Equal(t, "MCP-4", event.Issue.Key)
+	event = nextEvent(t, events)
+	assert.Equal(t, IssueRemoved, event.Type)
+	assert.Equal(t, "MCP-2", event.Issue.Key)
+
+	server.mu.Lock()
+	server.statuses = []int{http.StatusTooManyRequests, http.StatusInternalServerError}
+	server.mu.Unlock()
+	event = nextEvent(t, events)
+	assert.NotNil(t, event.Err)
+	assert.Equal(t, http.StatusInternalServerError, event.Err.(*ErrorResponse).StatusCode)
+
+	server.set("MCP-4")
+	event = nextEvent(t, events)
+	assert.Equal(t, IssueRemoved, event.Type)
+	assert.Equal(t, "MCP-1", event.Issue.Key)
+	event = nextEvent(t, events)
+	assert.Equal(t, IssueRemoved, event.Type)
+	assert.Equal(t, "MCP-3", event.Issue.Key)
+
+	cancel()
+	for range events {
+	}
+}
+
+func TestEpicsServiceWatchIssuesErrors(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/epic/MCP-5/issue", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	_, err := client.Epics.WatchIssues(context.Background(), "MCP-5", 0)
+	assert.NotNil(t, err)
+
+	_, err = client.Epics.WatchIssues(context.Background(), "MCP-5", time.Second)
+	assert.Equal(t, http.StatusNotFound, err.(*ErrorResponse).StatusCode)
+}
+
+func TestWatchBackoff(t *testing.T) {
+	assert.Equal(t, 2*time.Second, watchBackoff(time.Second, time.Second, nil))
+	assert.Equal(t, 16*time.Second, watchBackoff(10*time.Second, time.Second, nil))
+	assert.Equal(t, 30*time.Second, watchBackoff(time.Second, time.Second, &Response{RetryAfter: 30 * time.Second}))
+}
@@ -0,0 +1,79 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// WatchersService handles communication with the watcher related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/issue-getIssueWatchers
+type WatchersService service
+
+// List returns the watchers of an issue, for a given issue Id or issue key.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/watchers
+func (w *WatchersService) List(ctx context.Context, issueIDOrKey string) (*IssueWatch, *Response, error) {
+
+	req, err := w.client.NewRequest("GET", w.client.apiPath(fmt.Sprintf("issue/%s/watchers", issueIDOrKey)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var watch = &IssueWatch{}
+	resp, err := w.client.Do(ctx, req, watch)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return watch, resp, nil
+}
+
+// Add adds a user as a watcher of an issue, for a given issue Id or issue key and
+// user account id. The account id is sent as a JSON string in the body.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/watchers
+func (w *WatchersService) Add(ctx context.Context, issueIDOrKey string, accountID string) (bool, *Response, error) {
+
+	req, err := w.client.NewRequest("POST", w.client.apiPath(fmt.Sprintf("issue/%s/watchers", issueIDOrKey)), accountID)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := w.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
+
+// Remove removes a user from the watchers of an issue, for a given issue Id or
+// issue key and user account id. The account id is sent as a query parameter.
+//
+// DELETE /rest/api/2/issue/{issueIdOrKey}/watchers?accountId={accountId}
+func (w *WatchersService) Remove(ctx context.Context, issueIDOrKey string, accountID string) (bool, *Response, error) {
+
+	req, err := w.client.NewRequest("DELETE", w.client.apiPath(fmt.Sprintf("issue/%s/watchers?accountId=%s", issueIDOrKey, url.QueryEscape(accountID))), nil)
+	if err != nil {
+		return false, nil, err
+	}
+
+	resp, err := w.client.Do(ctx, req, nil)
+	if err != nil {
+		return false, resp, err
+	}
+
+	if resp.StatusCode == http.StatusNoContent {
+		return true, resp, nil
+	}
+
+	return false, resp, nil
+}
@@ -0,0 +1,60 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchersServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		fmt.Fprint(w, `{"isWatching": true,"watchCount": 2,"watchers": [{"accountId": "5b10a2844c20165700ede21g","displayName": "Mia Krystof"},{"accountId": "5b10ac8d82e05b22cc7d4ef5"}]}`)
+	})
+
+	watch, _, err := client.Watchers.List(context.Background(), "MCP-1")
+	assert.Nil(t, err)
+	assert.True(t, watch.Watching)
+	assert.Equal(t, 2, watch.Count)
+	assert.Len(t, watch.Watchers, 2)
+	assert.Equal(t, "5b10a2844c20165700ede21g", watch.Watchers[0].AccountID)
+	assert.Equal(t, "Mia Krystof", watch.Watchers[0].DisplayName)
+}
+
+func TestWatchersServiceAdd(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Equal(t, `"5b10ac8d82e05b22cc7d4ef5"`+"\n", string(body))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	added, _, err := client.Watchers.Add(context.Background(), "MCP-1", "5b10ac8d82e05b22cc7d4ef5")
+	assert.Nil(t, err)
+	assert.True(t, added)
+}
+
+func TestWatchersServiceRemove(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/watchers", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "5b10ac8d82e05b22cc7d4ef5", r.URL.Query().Get("accountId"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	removed, _, err := client.Watchers.Remove(context.Background(), "MCP-1", "5b10ac8d82e05b22cc7d4ef5")
+	assert.Nil(t, err)
+	assert.True(t, removed)
+}
@@ -0,0 +1,181 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// ErrWorkflowNotFound is returned by IssuesService.GetTransitionsGraph when no workflow is
+// associated with the project and issue type
+var ErrWorkflowNotFound = errors.New("jira: workflow not found")
+
+// Types of WorkflowTransition
+const (
+	// WorkflowTransitionInitial is the transition that creates the issue
+	WorkflowTransitionInitial = "initial"
+	// WorkflowTransitionGlobal is a transition available from every status
+	WorkflowTransitionGlobal = "global"
+	// WorkflowTransitionDirected is a transition available from the statuses in From
+	WorkflowTransitionDirected = "directed"
+)
+
+// WorkflowGraph represents the statuses of a workflow and the transitions between them
+type WorkflowGraph struct {
+	Name        string                `json:"name,omitempty"`
+	Statuses    []*WorkflowStatus     `json:"statuses,omitempty"`
+	Transitions []*WorkflowTransition `json:"transitions,omitempty"`
+}
+
+// WorkflowStatus represents a status of a workflow
+type WorkflowStatus struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// WorkflowTransition represents a transition of a workflow, from the statuses in From,
+// or from any status when it is global, to the status To
+type WorkflowTransition struct {
+	ID   string   `json:"id,omitempty"`
+	Name string   `json:"name,omitempty"`
+	From []string `json:"from,omitempty"`
+	To   string   `json:"to,omitempty"`
+	Type string   `json:"type,omitempty"`
+}
+
+// workflowSchemeProjectWrap represents the workflow schemes of projects
+type workflowSchemeProjectWrap struct {
+	Values []*struct {
+		WorkflowScheme *struct {
+			DefaultWorkflow   string            `json:"defaultWorkflow,omitempty"`
+			IssueTypeMappings map[string]string `json:"issueTypeMappings,omitempty"`
+		} `json:"workflowScheme,omitempty"`
+	} `json:"values,omitempty"`
+}
+
+// workflowWrap represents the workflows returned by the workflow search
+type workflowWrap struct {
+	Pagination
+	Values []*struct {
+		ID *struct {
+			Name string `json:"name,omitempty"`
+		} `json:"id,omitempty"`
+		Statuses    []*WorkflowStatus     `json:"statuses,omitempty"`
+		Transitions []*WorkflowTransition `json:"transitions,omitempty"`
+	} `json:"values,omitempty"`
+}
+
+// CanReach reports whether the status to can be reached from the status from through
+// the transitions of the workflow. The statuses are given by id or by name, ignoring case.
+func (g *WorkflowGraph) CanReach(from, to string) bool {
+	fromID, toID := g.statusID(from), g.statusID(to)
+	if fromID == "" || toID == "" {
+		return false
+	}
+
+	visited := map[string]bool{fromID: true}
+	queue := []string{fromID}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current == toID {
+			return true
+		}
+
+		for _, t := range g.Transitions {
+			if visited[t.To] || !t.availableFrom(current) {
+				continue
+			}
+			visited[t.To] = true
+			queue = append(queue, t.To)
+		}
+	}
+
+	return false
+}
+
+// statusID returns the id of a status of the workflow, given by id or name
+func (g *WorkflowGraph) statusID(idOrName string) string {
+	for _, s := range g.Statuses {
+		if s.ID == idOrName || strings.EqualFold(s.Name, idOrName) {
+			return s.ID
+		}
+	}
+	return ""
+}
+
+// availableFrom reports whether the transition can be performed from the given status
+func (t *WorkflowTransition) availableFrom(statusID string) bool {
+	switch t.Type {
+	case WorkflowTransitionInitial:
+		return false
+	case WorkflowTransitionGlobal:
+		return true
+	}
+
+	for _, from := range t.From {
+		if from == statusID {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTransitionsGraph returns the workflow used by the issues of a project, for a given project Id or
+// project key and issue type Id, as a graph of its statuses and transitions, e.g. to check with
+// WorkflowGraph.CanReach that a status can be reached before calling DoTransition. The workflow is
+// looked up in the workflow scheme of the project, so the user needs the permission to administer Jira.
+// ErrWorkflowNotFound is returned when the project has no workflow for the issue type.
+//
+// GET /rest/api/2/workflowscheme/project
+// GET /rest/api/2/workflow/search
+func (i *IssuesService) GetTransitionsGraph(ctx context.Context, projectIDOrKey string, issueTypeID string) (*WorkflowGraph, *Response, error) {
+	project, resp, err := i.client.Projects.Get(ctx, projectIDOrKey, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	req, err := i.client.NewRequest("GET", i.client.apiPath(fmt.Sprintf("workflowscheme/project?projectId=%s", project.ID)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var schemes = &workflowSchemeProjectWrap{}
+	resp, err = i.client.Do(ctx, req, schemes)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var name string
+	if len(schemes.Values) > 0 && schemes.Values[0].WorkflowScheme != nil {
+		scheme := schemes.Values[0].WorkflowScheme
+		name = scheme.DefaultWorkflow
+		if n, ok := scheme.IssueTypeMappings[issueTypeID]; ok {
+			name = n
+		}
+	}
+	if name == "" {
+		return nil, resp, ErrWorkflowNotFound
+	}
+
+	req, err = i.client.NewRequest("GET", i.client.apiPath(fmt.Sprintf("workflow/search?workflowName=%s&expand=statuses,transitions", url.QueryEscape(name))), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var workflows = &workflowWrap{}
+	resp, err = i.client.Do(ctx, req, workflows)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, w := range workflows.Values {
+		if w.ID != nil && w.ID.Name == name {
+			return &WorkflowGraph{Name: name, Statuses: w.Statuses, Transitions: w.Transitions}, resp, nil
+		}
+	}
+
+	return nil, resp, ErrWorkflowNotFound
+}
@@ -0,0 +1,88 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var workflowGraph = &WorkflowGraph{
+	Name: "Software Simplified Workflow",
+	Statuses: []*WorkflowStatus{
+		{ID: "1", Name: "To Do"},
+		{ID: "3", Name: "In Progress"},
+		{ID: "4", Name: "Review"},
+		{ID: "10001", Name: "Done"},
+		{ID: "5", Name: "Archived"},
+	},
+	Transitions: []*WorkflowTransition{
+		{ID: "1", Name: "Create", To: "1", Type: WorkflowTransitionInitial},
+		{ID: "11", Name: "Start", From: []string{"1"}, To: "3", Type: WorkflowTransitionDirected},
+		{ID: "21", Name: "Review", From: []string{"3"}, To: "4", Type: WorkflowTransitionDirected},
+		{ID: "31", Name: "Done", From: []string{"4"}, To: "10001", Type: WorkflowTransitionDirected},
+		{ID: "41", Name: "Archive", To: "5", Type: WorkflowTransitionGlobal},
+	},
+}
+
+func TestWorkflowGraphCanReach(t *testing.T) {
+	assert.True(t, workflowGraph.CanReach("1", "10001"))
+	assert.True(t, workflowGraph.CanReach("to do", "Done"))
+	assert.True(t, workflowGraph.CanReach("In Progress", "In Progress"))
+	assert.True(t, workflowGraph.CanReach("Review", "Archived"))
+	assert.False(t, workflowGraph.CanReach("Done", "To Do"))
+	assert.False(t, workflowGraph.CanReach("Archived", "Done"))
+	assert.False(t, workflowGraph.CanReach("To Do", "Closed"))
+}
+
+func TestIssuesServiceGetTransitionsGraph(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/project/MCP", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "10000","key": "MCP"}`)
+	})
+	mux.HandleFunc("/api/2/workflowscheme/project", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "10000", r.URL.Query().Get("projectId"))
+		fmt.Fprint(w, `{"values": [{"projectIds": ["10000"],"workflowScheme": {"defaultWorkflow": "jira","issueTypeMappings": {"10001": "Software Simplified Workflow"}}}]}`)
+	})
+	mux.HandleFunc("/api/2/workflow/search", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Software Simplified Workflow", r.URL.Query().Get("workflowName"))
+		assert.Equal(t, "statuses,transitions", r.URL.Query().Get("expand"))
+		fmt.Fprint(w, `{"isLast": true,"values": [{
+			"id": {"name": "Software Simplified Workflow"},
+			"statuses": [{"id": "1","name": "To Do"},{"id": "3","name": "In Progress"},{"id": "10001","name": "Done"}],
+			"transitions": [
+				{"id": "1","name": "Create","from": [],"to": "1","type": "initial"},
+				{"id": "11","name": "Start","from": ["1"],"to": "3","type": "directed"},
+				{"id": "21","name": "Done","from": ["3"],"to": "10001","type": "directed"}
+			]
+		}]}`)
+	})
+
+	graph, _, err := client.Issues.GetTransitionsGraph(context.Background(), "MCP", "10001")
+	assert.Nil(t, err)
+	assert.Equal(t, "Software Simplified Workflow", graph.Name)
+	assert.Len(t, graph.Statuses, 3)
+	assert.Len(t, graph.Transitions, 3)
+	assert.Equal(t, []string{"1"}, graph.Transitions[1].From)
+	assert.True(t, graph.CanReach("To Do", "Done"))
+	assert.False(t, graph.CanReach("Done", "To Do"))
+}
+
+func TestIssuesServiceGetTransitionsGraphNotFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/project/MCP", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id": "10000","key": "MCP"}`)
+	})
+	mux.HandleFunc("/api/2/workflowscheme/project", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"values": []}`)
+	})
+
+	_, _, err := client.Issues.GetTransitionsGraph(context.Background(), "MCP", "10001")
+	assert.Equal(t, ErrWorkflowNotFound, err)
+}
@@ -0,0 +1,187 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// WorklogsService handles communication with the worklog related
+// methods of the Jira API
+//
+// Jira API docs: https://docs.atlassian.com/software/jira/docs/api/REST/7.3.1/#api/2/issue-getIssueWorklog
+type WorklogsService service
+
+// Valid values of WorklogAdjustOptions.AdjustEstimate
+const (
+	//Sets the estimate to a specific value, defined by NewEstimate
+	AdjustEstimateNew = "new"
+	//Leaves the estimate unchanged
+	AdjustEstimateLeave = "leave"
+	//Changes the estimate by the value defined by ReduceBy (Add, Update) or IncreaseBy (Delete)
+	AdjustEstimateManual = "manual"
+	//Changes the estimate by the time spent of the worklog (default)
+	AdjustEstimateAuto = "auto"
+)
+
+// ErrNilWorklog is returned by WorklogsService.Add and Update when no worklog is given
+var ErrNilWorklog = errors.New("jira: the worklog is required")
+
+// newIssueWorklog contains the fields sent to add or update a worklog
+type newIssueWorklog struct {
+	Comment          interface{} `json:"comment,omitempty"`
+	Started          *DateTime   `json:"started,omitempty"`
+	TimeSpent        string      `json:"timeSpent,omitempty"`
+	TimeSpentSeconds int         `json:"timeSpentSeconds,omitempty"`
+}
+
+// WorklogOptions contains all options to list the worklogs of an issue
+type WorklogOptions struct {
+	//The index of the first worklog to return (0-based)
+	StartAt int `query:"startAt"`
+	//The maximum number of worklogs to return. Default: 5000.
+	MaxResults int `query:"maxResults"`
+}
+
+// WorklogAdjustOptions contains the options to recalculate the remaining estimate of the
+// issue when a worklog is added, updated or deleted
+type WorklogAdjustOptions struct {
+	//Defines how to update the remaining estimate. Valid values: new, leave, manual, auto. Default: auto.
+	AdjustEstimate string `query:"adjustEstimate"`
+	//The value to set as the remaining estimate, e.g. 2d. Required when AdjustEstimate is new.
+	NewEstimate string `query:"newEstimate"`
+	//The amount to reduce the remaining estimate by, e.g. 2d. Required on Add and Update when AdjustEstimate is manual.
+	ReduceBy string `query:"reduceBy"`
+	//The amount to increase the remaining estimate by, e.g. 2d. Required on Delete when AdjustEstimate is manual.
+	IncreaseBy string `query:"increaseBy"`
+	//Whether users watching the issue are notified by email. Default: true.
+	NotifyUsers *bool `query:"notifyUsers"`
+}
+
+// List returns the worklogs of an issue, for a given issue Id or issue key.
+//
+// GET /rest/api/2/issue/{issueIdOrKey}/worklog
+func (w *WorklogsService) List(ctx context.Context, issueIDOrKey string, opts *WorklogOptions) ([]*IssueWorklog, *Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := w.client.NewRequest("GET", w.client.apiPath(fmt.Sprintf("issue/%s/worklog%s", issueIDOrKey, q)), nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var wrap = &IssueWorklogWrap{}
+	resp, err := w.client.Do(ctx, req, wrap)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp.MaxResults = wrap.MaxResults
+	resp.StartAt = wrap.StartAt
+	resp.IsLast = wrap.StartAt+len(wrap.Worklogs) >= wrap.Total
+	resp.Total = wrap.Total
+	resp.pageLen = len(wrap.Worklogs)
+
+	return wrap.Worklogs, resp, nil
+}
+
+// Add adds a worklog to an issue, for a given issue Id or issue key. The time spent, the
+// start time and the comment of the worklog are sent. It returns the created worklog.
+// When the version 3 of the API is used (see WithAPIVersion and WithAutoAPIVersion), the comment is sent as an ADF
+// document, the worklog CommentDocument or, if it is nil, the Comment converted by TextToADF.
+//
+// POST /rest/api/2/issue/{issueIdOrKey}/worklog
+func (w *WorklogsService) Add(ctx context.Context, issueIDOrKey string, worklog *IssueWorklog, opts *WorklogAdjustOptions) (*IssueWorklog, *Response, error) {
+	if worklog == nil {
+		return nil, nil, ErrNilWorklog
+	}
+
+	if err := w.client.resolveAPIVersion(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	q := QueryParameters(opts)
+
+	req, err := w.client.NewRequest("POST", w.client.apiPath(fmt.Sprintf("issue/%s/worklog%s", issueIDOrKey, q)), w.toNewIssueWorklog(worklog))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var created = &IssueWorklog{}
+	resp, err := w.client.Do(ctx, req, created)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return created, resp, nil
+}
+
+// Update updates a worklog of an issue, for a given issue Id or issue key and worklog Id.
+// It returns the updated worklog. The comment is sent as by Add.
+//
+// PUT /rest/api/2/issue/{issueIdOrKey}/worklog/{id}
+func (w *WorklogsService) Update(ctx context.Context, issueIDOrKey string, worklogID string, worklog *IssueWorklog, opts *WorklogAdjustOptions) (*IssueWorklog, *Response, error) {
+	if worklog == nil {
+		return nil, nil, ErrNilWorklog
+	}
+
+	if err := w.client.resolveAPIVersion(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	q := QueryParameters(opts)
+
+	req, err := w.client.NewRequest("PUT", w.client.apiPath(fmt.Sprintf("issue/%s/worklog/%s%s", issueIDOrKey, worklogID, q)), w.toNewIssueWorklog(worklog))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var updated = &IssueWorklog{}
+	resp, err := w.client.Do(ctx, req, updated)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return updated, resp, nil
+}
+
+// Delete deletes a worklog of an issue, for a given issue Id or issue key and worklog Id.
+//
+// DELETE /rest/api/2/issue/{issueIdOrKey}/worklog/{id}
+func (w *WorklogsService) Delete(ctx context.Context, issueIDOrKey string, worklogID string, opts *WorklogAdjustOptions) (*Response, error) {
+
+	q := QueryParameters(opts)
+
+	req, err := w.client.NewRequest("DELETE", w.client.apiPath(fmt.Sprintf("issue/%s/worklog/%s%s", issueIDOrKey, worklogID, q)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.client.Do(ctx, req, nil)
+}
+
+// toNewIssueWorklog returns the fields of the worklog that can be sent to the API, the
+// comment being an ADF document on the version 3 of the API
+func (w *WorklogsService) toNewIssueWorklog(worklog *IssueWorklog) *newIssueWorklog {
+	wl := &newIssueWorklog{
+		TimeSpent:        worklog.TimeSpent,
+		TimeSpentSeconds: worklog.TimeSpentSeconds,
+	}
+	switch {
+	case w.client.version() != "3":
+		if worklog.Comment != "" {
+			wl.Comment = worklog.Comment
+		}
+	case worklog.CommentDocument != nil:
+		wl.Comment = worklog.CommentDocument
+	case worklog.Comment != "":
+		wl.Comment = TextToADF(worklog.Comment)
+	}
+	if !time.Time(worklog.StartedAt).IsZero() {
+		started := worklog.StartedAt
+		wl.Started = &started
+	}
+
+	return wl
+}
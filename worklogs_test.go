@@ -0,0 +1,176 @@
+package jira
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorklogsServiceList(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "GET", r.Method)
+		assert.Equal(t, "2", r.URL.Query().Get("maxResults"))
+		fmt.Fprint(w, `{"startAt": 0,"maxResults": 2,"total": 3,"worklogs": [{"id": "100","timeSpent": "1h","started": "2020-03-10T09:30:00.000+0000"},{"id": "101","timeSpentSeconds": 1800}]}`)
+	})
+
+	worklogs, resp, err := client.Worklogs.List(context.Background(), "MCP-1", &WorklogOptions{MaxResults: 2})
+	assert.Nil(t, err)
+	assert.Len(t, worklogs, 2)
+	assert.Equal(t, "100", worklogs[0].ID)
+	assert.Equal(t, "1h", worklogs[0].TimeSpent)
+	assert.Equal(t, 1800, worklogs[1].TimeSpentSeconds)
+	assert.False(t, resp.IsLast)
+	assert.Equal(t, 2, resp.MaxResults)
+}
+
+func TestWorklogsServiceAdd(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "POST", r.Method)
+		assert.Equal(t, "new", r.URL.Query().Get("adjustEstimate"))
+		assert.Equal(t, "1d", r.URL.Query().Get("newEstimate"))
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "2h", body["timeSpent"])
+		assert.Equal(t, "code review", body["comment"])
+		assert.Equal(t, "2020-03-10T09:30:00.000+0000", body["started"])
+
+		fmt.Fprint(w, `{"id": "100","issueId": "10001","timeSpent": "2h"}`)
+	})
+
+	wl := &IssueWorklog{
+		Comment:   "code review",
+		TimeSpent: "2h",
+		StartedAt: DateTime(time.Date(2020, 3, 10, 9, 30, 0, 0, time.UTC)),
+	}
+	opts := &WorklogAdjustOptions{AdjustEstimate: AdjustEstimateNew, NewEstimate: "1d"}
+	created, _, err := client.Worklogs.Add(context.Background(), "MCP-1", wl, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "100", created.ID)
+	assert.Equal(t, "10001", created.IssueID)
+}
+
+func TestWorklogsServiceUpdate(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/worklog/100", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "PUT", r.Method)
+		assert.Equal(t, "leave", r.URL.Query().Get("adjustEstimate"))
+
+		body := map[string]interface{}{}
+		json.NewDecoder(r.Body).Decode(&body)
+		assert.Equal(t, "3h", body["timeSpent"])
+		_, ok := body["started"]
+		assert.False(t, ok)
+
+		fmt.Fprint(w, `{"id": "100","timeSpent": "3h"}`)
+	})
+
+	opts := &WorklogAdjustOptions{AdjustEstimate: AdjustEstimateLeave}
+	updated, _, err := client.Worklogs.Update(context.Background(), "MCP-1", "100", &IssueWorklog{TimeSpent: "3h"}, opts)
+	assert.Nil(t, err)
+	assert.Equal(t, "3h", updated.TimeSpent)
+}
+
+func TestWorklogsServiceNilWorklog(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	_, _, err := client.Worklogs.Add(context.Background(), "MCP-1", nil, nil)
+	assert.Equal(t, ErrNilWorklog, err)
+
+	_, _, err = client.Worklogs.Update(context.Background(), "MCP-1", "10000", nil, nil)
+	assert.Equal(t, ErrNilWorklog, err)
+}
+
+func TestWorklogsServiceDelete(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/2/issue/MCP-1/worklog/100", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "DELETE", r.Method)
+		assert.Equal(t, "manual", r.URL.Query().Get("adjustEstimate"))
+		assert.Equal(t, "2h", r.URL.Query().Get("increaseBy"))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opts := &WorklogAdjustOptions{AdjustEstimate: AdjustEstimateManual, IncreaseBy: "2h"}
+	resp, err := client.Worklogs.Delete(context.Background(), "MCP-1", "100", opts)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestDateTimeMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(DateTime(time.Date(2020, 3, 10, 9, 30, 0, 0, time.UTC)))
+	assert.Nil(t, err)
+	assert.Equal(t, `"2020-03-10T09:30:00.000+0000"`, string(b))
+
+	b, err = json.Marshal(DateTime{})
+	assert.Nil(t, err)
+	assert.Equal(t, "null", string(b))
+}
+
+// worklogADFComment is the comment of a worklog returned by the version 3 of the API
+const worklogADFComment = `{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"code review"}]}]}`
+
+func TestWorklogsServiceListADF(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithAPIVersion("3")(client)
+
+	mux.HandleFunc("/api/3/issue/MCP-1/worklog", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"startAt": 0,"maxResults": 1,"total": 1,"worklogs": [{"id": "100","comment": %s,"timeSpent": "2h"}]}`, worklogADFComment)
+	})
+
+	worklogs, _, err := client.Worklogs.List(context.Background(), "MCP-1", nil)
+	assert.Nil(t, err)
+	assert.Len(t, worklogs, 1)
+	assert.Equal(t, "", worklogs[0].Comment)
+	assert.Equal(t, TextToADF("code review"), worklogs[0].CommentDocument)
+}
+
+func TestWorklogsServiceAddADF(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	WithAPIVersion("3")(client)
+
+	var bodies []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		fmt.Fprintf(w, `{"id": "100","comment": %s}`, worklogADFComment)
+	}
+	mux.HandleFunc("/api/3/issue/MCP-1/worklog", handler)
+	mux.HandleFunc("/api/3/issue/MCP-1/worklog/100", handler)
+
+	created, _, err := client.Worklogs.Add(context.Background(), "MCP-1", &IssueWorklog{Comment: "code review", TimeSpent: "2h"}, nil)
+	assert.Nil(t, err)
+	assert.Equal(t, TextToADF("code review"), created.CommentDocument)
+
+	doc := NewADF().Paragraph("updated").Build()
+	_, _, err = client.Worklogs.Update(context.Background(), "MCP-1", "100", &IssueWorklog{Comment: "ignored", CommentDocument: doc}, nil)
+	assert.Nil(t, err)
+
+	_, _, err = client.Worklogs.Add(context.Background(), "MCP-1", &IssueWorklog{TimeSpent: "1h"}, nil)
+	assert.Nil(t, err)
+
+	assert.Len(t, bodies, 3)
+	assert.JSONEq(t, `{"comment":`+worklogADFComment+`,"timeSpent":"2h"}`, bodies[0])
+	assert.JSONEq(t, `{"comment":{"version":1,"type":"doc","content":[{"type":"paragraph","content":[{"type":"text","text":"updated"}]}]}}`, bodies[1])
+	assert.JSONEq(t, `{"timeSpent":"1h"}`, bodies[2])
+}